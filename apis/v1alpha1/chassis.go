@@ -0,0 +1,52 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ChassisStatus defines the observed state of Chassis
+type ChassisStatus struct {
+	// Hostname is the hostname of the chassis
+	Hostname string `json:"hostname,omitempty"`
+
+	// EncapIPs is the list of tunnel encapsulation IP addresses of the chassis
+	EncapIPs []string `json:"encapIPs,omitempty"`
+
+	// GatewayCapable indicates if the chassis can host gateway router ports
+	GatewayCapable bool `json:"gatewayCapable,omitempty"`
+
+	// Alive indicates if the chassis is keeping up with nb_cfg updates
+	Alive bool `json:"alive"`
+
+	// NbCfgLag is the number of nb_cfg sequence numbers the chassis is behind
+	NbCfgLag int `json:"nbCfgLag,omitempty"`
+
+	// LastSeen is the last time the chassis caught up with nb_cfg, if known
+	LastSeen *metav1.Time `json:"lastSeen,omitempty"`
+
+	// ActiveRouters is the number of routers currently hosted on the chassis
+	ActiveRouters int `json:"activeRouters"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// Chassis represents an OVN chassis
+type Chassis struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status ChassisStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ChassisList contains a list of Chassis
+type ChassisList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Chassis `json:"items"`
+}
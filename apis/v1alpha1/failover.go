@@ -0,0 +1,72 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FailoverPriorityChange defines a priority change of a gateway chassis member
+type FailoverPriorityChange struct {
+	// Table is the OVN table of the row, either HA_Chassis or Gateway_Chassis
+	Table string `json:"table"`
+
+	// UUID is the UUID of the row
+	UUID string `json:"uuid"`
+
+	// ChassisName is the name of the chassis referenced by the row
+	ChassisName string `json:"chassisName"`
+
+	// OldPriority is the priority of the row before the failover
+	OldPriority int `json:"oldPriority"`
+
+	// NewPriority is the priority of the row after the failover
+	NewPriority int `json:"newPriority"`
+}
+
+// FailoverPlanSpec defines the changes a failover makes to a router
+type FailoverPlanSpec struct {
+	// Strategy is the failover strategy used to compute the changes
+	Strategy string `json:"strategy,omitempty"`
+
+	// CurrentChassis is the chassis currently hosting the router
+	CurrentChassis string `json:"currentChassis,omitempty"`
+
+	// TargetChassis is the chassis which will host the router after the failover
+	TargetChassis string `json:"targetChassis,omitempty"`
+
+	// Changes is the list of priority changes to apply
+	Changes []FailoverPriorityChange `json:"changes,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FailoverPlan represents the changes a failover makes to a router
+type FailoverPlan struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec FailoverPlanSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FailoverPlanList contains a list of FailoverPlan
+type FailoverPlanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FailoverPlan `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+
+// FailoverJournal records the failover plans applied by a failover, including
+// the original priorities of every changed row, so that it can be reverted
+type FailoverJournal struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Plans is the list of failover plans which were applied
+	Plans []FailoverPlan `json:"plans"`
+}
@@ -0,0 +1,57 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// FloatingIPStatus defines the observed state of FloatingIP
+type FloatingIPStatus struct {
+	// InternalUUID is the internal UUID of the NAT row
+	InternalUUID *types.UID `json:"internalUUID,omitempty"`
+
+	// FloatingIP is the external IP address of the floating IP
+	FloatingIP string `json:"floatingIP,omitempty"`
+
+	// FixedIP is the internal IP address the floating IP is translated to
+	FixedIP string `json:"fixedIP,omitempty"`
+
+	// RouterID is the UUID of the router the floating IP is configured on
+	RouterID types.UID `json:"routerID,omitempty"`
+
+	// LogicalPort is the UUID of the port the floating IP is associated with
+	LogicalPort string `json:"logicalPort,omitempty"`
+
+	// ExternalMAC is the MAC address used by distributed floating IPs
+	ExternalMAC string `json:"externalMAC,omitempty"`
+
+	// Distributed indicates if the floating IP is served by the chassis of the
+	// port instead of the gateway chassis of the router
+	Distributed bool `json:"distributed,omitempty"`
+
+	// Chassis is the name of the chassis currently serving the floating IP
+	Chassis string `json:"chassis,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// FloatingIP represents an OVN NAT entry backing a Neutron floating IP
+type FloatingIP struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status FloatingIPStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FloatingIPList contains a list of FloatingIP
+type FloatingIPList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FloatingIP `json:"items"`
+}
@@ -0,0 +1,76 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// LoadBalancerMember defines a backend member of a load balancer listener
+type LoadBalancerMember struct {
+	// Address is the IP address and port of the member
+	Address string `json:"address"`
+
+	// Status is the health check status of the member reported by the service
+	// monitor, either online, offline or error, it is unset if not monitored
+	Status string `json:"status,omitempty"`
+}
+
+// LoadBalancerListener defines a virtual IP and port of a load balancer
+type LoadBalancerListener struct {
+	// Protocol is the protocol of the listener
+	Protocol string `json:"protocol"`
+
+	// VIP is the virtual IP address and port of the listener
+	VIP string `json:"vip"`
+
+	// HealthChecked indicates if the members of the listener are health checked
+	HealthChecked bool `json:"healthChecked,omitempty"`
+
+	// Members is the list of backend members of the listener
+	Members []LoadBalancerMember `json:"members,omitempty"`
+}
+
+// LoadBalancerStatus defines the observed state of LoadBalancer
+type LoadBalancerStatus struct {
+	// InternalUUIDs is the list of internal UUIDs of the load balancer rows,
+	// one for every protocol used by the listeners
+	InternalUUIDs []types.UID `json:"internalUUIDs,omitempty"`
+
+	// VIP is the virtual IP address of the load balancer
+	VIP string `json:"vip,omitempty"`
+
+	// FloatingIP is the floating IP address associated with the virtual IP
+	FloatingIP string `json:"floatingIP,omitempty"`
+
+	// Listeners is the list of listeners of the load balancer
+	Listeners []LoadBalancerListener `json:"listeners,omitempty"`
+
+	// Routers is the list of UUIDs of the routers the load balancer is attached to
+	Routers []types.UID `json:"routers,omitempty"`
+
+	// Networks is the list of UUIDs of the networks the load balancer is attached to
+	Networks []types.UID `json:"networks,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// LoadBalancer represents an OVN load balancer created by the Octavia OVN provider
+type LoadBalancer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status LoadBalancerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoadBalancerList contains a list of LoadBalancer
+type LoadBalancerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoadBalancer `json:"items"`
+}
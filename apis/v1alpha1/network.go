@@ -0,0 +1,51 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// NetworkStatus defines the observed state of Network
+type NetworkStatus struct {
+	// InternalUUID is the internal UUID of the logical switch
+	InternalUUID *types.UID `json:"internalUUID,omitempty"`
+
+	// NetworkType is the provider network type, either flat, vlan or overlay
+	NetworkType string `json:"networkType,omitempty"`
+
+	// PhysicalNetwork is the physical network of flat and vlan networks
+	PhysicalNetwork string `json:"physicalNetwork,omitempty"`
+
+	// SegmentationID is the VLAN ID of vlan networks
+	SegmentationID *int `json:"segmentationID,omitempty"`
+
+	// MTU is the MTU of the network
+	MTU int `json:"mtu,omitempty"`
+
+	// Ports is the number of ports attached to the network, excluding the
+	// localnet ports
+	Ports int `json:"ports"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// Network represents an OVN logical switch backing a Neutron network
+type Network struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status NetworkStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkList contains a list of Network
+type NetworkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Network `json:"items"`
+}
@@ -0,0 +1,56 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// PortStatus defines the observed state of Port
+type PortStatus struct {
+	// InternalUUID is the internal UUID of the logical switch port
+	InternalUUID *types.UID `json:"internalUUID,omitempty"`
+
+	// NetworkID is the UUID of the network the port is attached to
+	NetworkID types.UID `json:"networkID,omitempty"`
+
+	// DeviceOwner is the Neutron device owner of the port
+	DeviceOwner string `json:"deviceOwner,omitempty"`
+
+	// DeviceID is the UUID of the device using the port
+	DeviceID string `json:"deviceID,omitempty"`
+
+	// MACAddress is the MAC address of the port
+	MACAddress string `json:"macAddress,omitempty"`
+
+	// IPAddresses is the list of IP addresses of the port
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+
+	// Up indicates if the port is up, it is unset if OVN did not report it
+	Up *bool `json:"up,omitempty"`
+
+	// Chassis is the name of the chassis the port is bound to
+	Chassis string `json:"chassis,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// Port represents an OVN logical switch port backing a Neutron port
+type Port struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status PortStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PortList contains a list of Port
+type PortList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Port `json:"items"`
+}
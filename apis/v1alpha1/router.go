@@ -20,6 +20,17 @@ type RouterPortInfo struct {
 	IsGateway bool `json:"isGateway,omitempty"`
 }
 
+// RouterGatewayChassis defines a chassis which is a member of the gateway set
+// of a router
+type RouterGatewayChassis struct {
+	// Name is the name of the chassis
+	Name string `json:"name"`
+
+	// Priority is the priority of the chassis, the highest priority chassis
+	// is expected to host the router
+	Priority int `json:"priority"`
+}
+
 // RouterStatus defines the observed state of Router
 type RouterStatus struct {
 	// Agent is the UUID of the agent hosting this router
@@ -33,6 +44,19 @@ type RouterStatus struct {
 
 	// Ports is the list of port UUIDs associated with this router
 	Ports []RouterPortInfo `json:"ports,omitempty"`
+
+	// Enabled indicates if the router is administratively enabled
+	Enabled bool `json:"enabled"`
+
+	// GatewayChassis is the list of chassis which can host the gateway port of
+	// the router, sorted from the highest to the lowest priority
+	GatewayChassis []RouterGatewayChassis `json:"gatewayChassis,omitempty"`
+
+	// NATs is the number of NAT rules of the router
+	NATs int `json:"nats"`
+
+	// StaticRoutes is the number of static routes of the router
+	StaticRoutes int `json:"staticRoutes"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,66 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// SecurityGroupACL defines an OVN ACL implementing a security group rule
+type SecurityGroupACL struct {
+	// InternalUUID is the internal UUID of the ACL
+	InternalUUID types.UID `json:"internalUUID"`
+
+	// RuleID is the UUID of the Neutron security group rule, if any
+	RuleID types.UID `json:"ruleID,omitempty"`
+
+	// Direction is the direction of the traffic from the point of view of the
+	// port, either ingress or egress
+	Direction string `json:"direction"`
+
+	// Priority is the priority of the ACL
+	Priority int `json:"priority"`
+
+	// Action is the action of the ACL, such as allow-related or drop
+	Action string `json:"action"`
+
+	// Match is the OVN match expression of the ACL
+	Match string `json:"match"`
+}
+
+// SecurityGroupStatus defines the observed state of SecurityGroup
+type SecurityGroupStatus struct {
+	// InternalUUID is the internal UUID of the port group
+	InternalUUID *types.UID `json:"internalUUID,omitempty"`
+
+	// PortGroup is the name of the port group
+	PortGroup string `json:"portGroup,omitempty"`
+
+	// Ports is the number of ports which are members of the security group
+	Ports int `json:"ports"`
+
+	// ACLs is the list of ACLs of the security group
+	ACLs []SecurityGroupACL `json:"acls,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// SecurityGroup represents an OVN port group backing a Neutron security group
+type SecurityGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status SecurityGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecurityGroupList contains a list of SecurityGroup
+type SecurityGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecurityGroup `json:"items"`
+}
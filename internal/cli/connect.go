@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ovn-org/libovsdb/client"
+	"github.com/ovn-org/libovsdb/model"
+	"github.com/ovn-org/libovsdb/ovsdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+
+	"github.com/vexxhost/atmosphere/internal/ovnrouter"
+)
+
+// connectToOVNDatabase establishes a connection to an OVN database and monitors
+// the given tables
+func connectToOVNDatabase(ctx context.Context, name string, endpoints []string, tables map[string]model.Model, opts ...client.Option) (client.Client, error) {
+	opts = append([]client.Option{client.WithEndpoint(strings.Join(endpoints, ","))}, opts...)
+
+	// NOTE: The model of logical router ports has the status column added in
+	//       OVN 23.09, so the schema of older servers is checked first to use
+	//       a model without it.
+	if _, ok := tables[nbdb.LogicalRouterPortTable]; ok {
+		schema, err := fetchSchema(ctx, name, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		tables = ovnrouter.CompatibleTables(schema, tables)
+	}
+
+	// Get database model
+	dbModel, err := model.NewClientDBModel(name, tables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database model: %w", err)
+	}
+
+	// Create client
+	ovnClient, err := client.NewOVSDBClient(dbModel, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OVN client: %w", err)
+	}
+
+	// Connect
+	if err := ovnClient.Connect(ctx); err != nil {
+		return nil, fmt.Errorf("failed to connect to OVN: %w", err)
+	}
+
+	// Monitor the database
+	if _, err := ovnClient.MonitorAll(ctx); err != nil {
+		ovnClient.Close()
+		return nil, fmt.Errorf("failed to monitor OVN database: %w", err)
+	}
+
+	return ovnClient, nil
+}
+
+// fetchSchema returns the schema of an OVN database served at the endpoints
+// of the options, with a client which has no model for any of its tables
+func fetchSchema(ctx context.Context, name string, opts ...client.Option) (ovsdb.DatabaseSchema, error) {
+	dbModel, err := model.NewClientDBModel(name, map[string]model.Model{})
+	if err != nil {
+		return ovsdb.DatabaseSchema{}, fmt.Errorf("failed to get database model: %w", err)
+	}
+
+	ovnClient, err := client.NewOVSDBClient(dbModel, opts...)
+	if err != nil {
+		return ovsdb.DatabaseSchema{}, fmt.Errorf("failed to create OVN client: %w", err)
+	}
+
+	if err := ovnClient.Connect(ctx); err != nil {
+		return ovsdb.DatabaseSchema{}, fmt.Errorf("failed to connect to OVN: %w", err)
+	}
+	defer ovnClient.Close()
+
+	return ovnClient.Schema(), nil
+}
@@ -0,0 +1,169 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/vexxhost/atmosphere/internal/cli/resources"
+)
+
+// DescribeCmd handles the describe command
+type DescribeCmd struct {
+	configFlags *genericclioptions.ConfigFlags
+	registry    *resources.Registry
+	ovnConfig   *resources.OVNConfig
+
+	// Command options
+	ovnEndpoints   []string
+	ovnSBEndpoints []string
+	ovnNamespace   string
+}
+
+// NewDescribeCommand creates a new describe command
+func NewDescribeCommand(configFlags *genericclioptions.ConfigFlags) *cobra.Command {
+	d := &DescribeCmd{
+		configFlags: configFlags,
+		registry:    resources.NewRegistry(),
+		ovnConfig:   resources.DefaultOVNConfig(),
+	}
+
+	// Register all resources
+	registerResources(d.registry)
+
+	cmd := &cobra.Command{
+		Use:   "describe [resource] [name...]",
+		Short: "Show details of a specific resource",
+		Long:  d.getLongDescription(),
+		RunE:  d.run,
+	}
+
+	// OVN configuration flags
+	cmd.Flags().StringSliceVar(&d.ovnEndpoints, "ovn-endpoints", nil, "OVN database endpoints (default: auto-generated from namespace and statefulset)")
+	cmd.Flags().StringSliceVar(&d.ovnSBEndpoints, "ovn-sb-endpoints", nil, "OVN southbound database endpoints (default: auto-generated from namespace and statefulset)")
+	cmd.Flags().StringVar(&d.ovnNamespace, "ovn-namespace", "openstack", "Namespace where OVN is deployed")
+
+	return cmd
+}
+
+// describableResources returns the names of the resources which can be described
+func (d *DescribeCmd) describableResources() []string {
+	var names []string
+	for _, name := range d.registry.List() {
+		resource, _ := d.registry.Get(name)
+		if _, ok := resource.(resources.DescribeResource); ok {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// getLongDescription builds the long description with available resources
+func (d *DescribeCmd) getLongDescription() string {
+	resourceList := strings.Join(d.describableResources(), ", ")
+
+	return fmt.Sprintf(`Show details of a specific resource.
+
+Prints a detailed description of the specified resources, including the
+related OVN objects which are not shown by the get command.
+
+Available resources: %s
+
+Examples:
+  # Describe a router with its ports, gateway chassis, NAT rules and routes
+  atmosphere describe router 550e8400-e29b-41d4-a716-446655440000
+
+  # Describe a router (slash notation)
+  atmosphere describe router/550e8400-e29b-41d4-a716-446655440000
+
+  # Describe a router by its Neutron name or a prefix of its UUID
+  atmosphere describe router/MyRouter
+  atmosphere describe router 550e8400
+
+  # Describe multiple routers
+  atmosphere describe routers uuid1 uuid2`, resourceList)
+}
+
+// run executes the describe command
+func (d *DescribeCmd) run(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("you must specify the type of resource to describe. Available resources: %s",
+			strings.Join(d.describableResources(), ", "))
+	}
+
+	// Parse resource type and names (supporting both "resource name" and "resource/name" formats)
+	resourceType, resourceNames, err := parseResourceArgs(args)
+	if err != nil {
+		return err
+	}
+
+	// Get the resource handler
+	resource, ok := d.registry.Get(resourceType)
+	if !ok {
+		return fmt.Errorf("unknown resource type: %s. Available resources: %s",
+			resourceType, strings.Join(d.describableResources(), ", "))
+	}
+
+	describeResource, ok := resource.(resources.DescribeResource)
+	if !ok {
+		return fmt.Errorf("resource type %s can not be described. Available resources: %s",
+			resourceType, strings.Join(d.describableResources(), ", "))
+	}
+
+	if len(resourceNames) == 0 {
+		return fmt.Errorf("you must specify the name or UUID of the %s to describe", resource.Name())
+	}
+
+	// Update OVN config with command line options
+	if len(d.ovnEndpoints) > 0 {
+		d.ovnConfig.Endpoints = d.ovnEndpoints
+	}
+	if len(d.ovnSBEndpoints) > 0 {
+		d.ovnConfig.SBEndpoints = d.ovnSBEndpoints
+	}
+	if d.ovnNamespace != "" {
+		d.ovnConfig.Namespace = d.ovnNamespace
+	}
+
+	// Connect to OVN
+	ctx := context.Background()
+	ovnClient, err := connectToOVNDatabase(ctx, "OVN_Northbound", d.ovnConfig.GetNBEndpoints(), describeResource.DescribeNorthboundTables())
+	if err != nil {
+		return err
+	}
+	defer ovnClient.Close()
+
+	clients := &resources.Clients{NB: ovnClient}
+
+	// Connect to the southbound database if the resource needs it
+	if tables := southboundTables(resource, ovnClient); tables != nil {
+		sbClient, err := connectToOVNDatabase(ctx, "OVN_Southbound", d.ovnConfig.GetSBEndpoints(), tables)
+		if err != nil {
+			return err
+		}
+		defer sbClient.Close()
+
+		clients.SB = sbClient
+	}
+
+	// Describe each resource, separated by a blank line like kubectl does
+	for i, name := range resourceNames {
+		if i > 0 {
+			fmt.Fprintln(os.Stdout)
+		}
+
+		if err := describeResource.Describe(ctx, clients, name, os.Stdout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
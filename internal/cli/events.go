@@ -0,0 +1,166 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"slices"
+	"syscall"
+	"time"
+
+	"github.com/ovn-org/libovsdb/client"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/vexxhost/atmosphere/internal/cli/resources"
+	"github.com/vexxhost/atmosphere/internal/ovnevents"
+)
+
+// EventsCmd handles the events command
+type EventsCmd struct {
+	configFlags *genericclioptions.ConfigFlags
+	ovnConfig   *resources.OVNConfig
+
+	// Command options
+	outputFormat   string
+	types          []string
+	ovnEndpoints   []string
+	ovnSBEndpoints []string
+	ovnNamespace   string
+}
+
+// NewEventsCommand creates a new events command
+func NewEventsCommand(configFlags *genericclioptions.ConfigFlags) *cobra.Command {
+	e := &EventsCmd{
+		configFlags: configFlags,
+		ovnConfig:   resources.DefaultOVNConfig(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "events",
+		Short: "Stream changes of routers, chassis and port bindings",
+		Long: fmt.Sprintf(`Stream changes of routers, chassis and port bindings.
+
+This command watches the northbound and southbound databases and prints an
+event every time a router moves to another chassis, a chassis becomes alive,
+stops being alive or is removed, or a port is bound to a chassis, until it is
+interrupted.
+
+A chassis is alive while it keeps up with nb_cfg updates, the same way the
+failover health checks decide it, so a chassis which crashed or is partitioned
+is reported down even though its row is kept in the southbound database.
+
+Event types: %s, %s, %s, %s, %s, %s
+
+Examples:
+  # Stream all events
+  atmosphere events
+
+  # Stream router moves while failing over routers from another terminal
+  atmosphere events --types RouterMoved
+
+  # Stream events as JSON lines for a log pipeline
+  atmosphere events -o json`,
+			ovnevents.TypeRouterMoved, ovnevents.TypeChassisUp, ovnevents.TypeChassisDown,
+			ovnevents.TypeChassisRemoved, ovnevents.TypePortBound, ovnevents.TypePortUnbound),
+		RunE: e.run,
+	}
+
+	// Add flags
+	cmd.Flags().StringVarP(&e.outputFormat, "output", "o", "", "Output format. One of: (json)")
+	cmd.Flags().StringSliceVar(&e.types, "types", nil, "Only print events of the given types")
+
+	// OVN configuration flags
+	cmd.Flags().StringSliceVar(&e.ovnEndpoints, "ovn-endpoints", nil, "OVN database endpoints (default: auto-generated from namespace and statefulset)")
+	cmd.Flags().StringSliceVar(&e.ovnSBEndpoints, "ovn-sb-endpoints", nil, "OVN southbound database endpoints (default: auto-generated from namespace and statefulset)")
+	cmd.Flags().StringVar(&e.ovnNamespace, "ovn-namespace", "openstack", "Namespace where OVN is deployed")
+
+	return cmd
+}
+
+// run executes the events command
+func (e *EventsCmd) run(cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %v", args)
+	}
+
+	if e.outputFormat != "" && e.outputFormat != "json" {
+		return fmt.Errorf("unsupported output format: %s", e.outputFormat)
+	}
+
+	// Update OVN config with command line options
+	if len(e.ovnEndpoints) > 0 {
+		e.ovnConfig.Endpoints = e.ovnEndpoints
+	}
+	if len(e.ovnSBEndpoints) > 0 {
+		e.ovnConfig.SBEndpoints = e.ovnSBEndpoints
+	}
+	if e.ovnNamespace != "" {
+		e.ovnConfig.Namespace = e.ovnNamespace
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Connect to OVN
+	ovnClient, err := e.connectToOVN(ctx)
+	if err != nil {
+		return err
+	}
+	defer ovnClient.Close()
+
+	sbClient, err := e.connectToOVNSouthbound(ctx)
+	if err != nil {
+		return err
+	}
+	defer sbClient.Close()
+
+	events, err := ovnevents.NewSource(ovnClient, ovnevents.WithSouthbound(sbClient)).Start(ctx)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-events:
+			if len(e.types) > 0 && !slices.Contains(e.types, string(event.Type)) {
+				continue
+			}
+
+			if err := e.printEvent(&event, os.Stdout); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// printEvent prints an event as a single line, either human readable or JSON
+func (e *EventsCmd) printEvent(event *ovnevents.Event, out io.Writer) error {
+	if e.outputFormat == "json" {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(out, "%s\n", data)
+		return err
+	}
+
+	_, err := fmt.Fprintf(out, "%s  %-14s %-50s %s\n", event.Time.Format(time.RFC3339), event.Type, event.Object, event.Message)
+	return err
+}
+
+// connectToOVNSouthbound establishes connection to the OVN southbound database
+func (e *EventsCmd) connectToOVNSouthbound(ctx context.Context) (client.Client, error) {
+	return connectToOVNDatabase(ctx, "OVN_Southbound", e.ovnConfig.GetSBEndpoints(), ovnevents.SouthboundTables())
+}
+
+// connectToOVN establishes connection to OVN database
+func (e *EventsCmd) connectToOVN(ctx context.Context) (client.Client, error) {
+	return connectToOVNDatabase(ctx, "OVN_Northbound", e.ovnConfig.GetNBEndpoints(), ovnevents.NorthboundTables())
+}
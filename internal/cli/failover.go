@@ -3,18 +3,21 @@ package cli
 import (
 	"context"
 	"fmt"
+	"maps"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/ovn-org/libovsdb/client"
-	"github.com/ovn-org/libovsdb/model"
-	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/printers"
 	"k8s.io/cli-runtime/pkg/resource"
 
 	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
 	"github.com/vexxhost/atmosphere/internal/cli/resources"
+	"github.com/vexxhost/atmosphere/internal/ovnhealth"
 	"github.com/vexxhost/atmosphere/internal/ovnrouter"
 )
 
@@ -24,10 +27,22 @@ type FailoverCmd struct {
 	ovnConfig   *resources.OVNConfig
 
 	// Command options
-	ovnEndpoints []string
-	ovnNamespace string
-	timeout      time.Duration
-	all          bool
+	ovnEndpoints    []string
+	ovnSBEndpoints  []string
+	ovnNamespace    string
+	skipHealthCheck bool
+	timeout         time.Duration
+	all             bool
+	fromChassis     string
+	project         string
+	toChassis       string
+	strategy        string
+	dryRun          bool
+	outputFormat    string
+	parallelism     int
+	journal         string
+	revert          string
+	retries         int
 }
 
 // NewFailoverCommand creates a new failover command
@@ -39,12 +54,43 @@ func NewFailoverCommand(configFlags *genericclioptions.ConfigFlags) *cobra.Comma
 	}
 
 	cmd := &cobra.Command{
-		Use:   "failover [router-uuid...]",
+		Use:   "failover [router...]",
 		Short: "Trigger failover for one or more routers",
 		Long: `Trigger failover for one or more routers.
 
 This command will move routers from their current hosting gateway chassis 
-to the next available one by swapping priorities between the highest and lowest.
+to the next available one by rewriting the gateway chassis priorities using
+one of the following strategies:
+
+  swap    Swap priorities between the highest and lowest (default)
+  rotate  Move the active chassis to the lowest priority and shift every
+          other chassis up by one, preserving their relative order
+  demote  Same resulting order as rotate, but only lowers the priority of
+          the active chassis
+
+When --to-chassis is given, the named chassis is promoted to the highest priority
+instead.
+
+Chassis which are not alive or not gateway capable according to the southbound
+database are skipped when selecting the chassis to move a router to, and a
+failover to such a chassis with --to-chassis is refused. Use --skip-health-check
+to disable these checks.
+
+Priorities are only changed if they still match the ones read when computing
+the failover, otherwise the failover is recomputed and retried up to --retries
+times so that concurrent changes by Neutron or another operator are never
+overwritten.
+
+Before changing any priorities, a journal recording the original priorities of
+every changed row is written to the path given by --journal, and it is updated
+afterwards when failovers were recomputed after a conflict or not applied. The
+failover can be undone later by passing that journal to --revert, which aborts
+for any router whose priorities were changed since the journal was written.
+
+Routers are selected by UUID, Neutron name or unique UUID prefix, a name or
+prefix matching more than one router is refused and lists the matching routers.
+The routers can be restricted to the ones owned by a Neutron project with
+--project.
 
 Examples:
   # Failover a single router
@@ -55,13 +101,46 @@ Examples:
   
   # Failover multiple routers using comma-separated UUIDs
   atmosphere failover uuid1,uuid2,uuid3
+
+  # Failover a router by its Neutron name or a UUID prefix
+  atmosphere failover router-1 550e8400
+
+  # Failover all routers of a project
+  atmosphere failover --project 3a9c1b2e4d5f6a7b8c9d0e1f2a3b4c5d
   
   # Failover all routers
   atmosphere failover --all
+
+  # Move all routers away from a gateway chassis before maintenance
+  atmosphere failover --from-chassis network-node-1
+
+  # Failover a router to a specific gateway chassis
+  atmosphere failover uuid1 --to-chassis network-node-2
+
+  # Drain a chassis without bouncing routers back onto previously drained ones
+  atmosphere failover --from-chassis network-node-1 --strategy=rotate
+
+  # Review the priority changes of a mass failover without applying them
+  atmosphere failover --all --dry-run
+
+  # Print the failover plan in JSON format
+  atmosphere failover --all --dry-run -o json
+
+  # Move all routers from one gateway chassis to another
+  atmosphere failover --from-chassis network-node-1 --to-chassis network-node-2
   
+  # Failover all routers, 20 at a time
+  atmosphere failover --all --parallelism 20
+
   # Failover with custom timeout
   atmosphere failover uuid1 --timeout=60s
   
+  # Write the failover journal to a specific path
+  atmosphere failover --all --journal /var/tmp/failover.json
+
+  # Revert a previous failover using its journal
+  atmosphere failover --revert /var/tmp/failover.json
+
   # Use custom OVN endpoints
   atmosphere failover uuid1 --ovn-endpoints tcp://ovn-nb-0:6641,tcp://ovn-nb-1:6641`,
 		RunE: f.run,
@@ -69,10 +148,22 @@ Examples:
 
 	// Add flags
 	cmd.Flags().BoolVar(&f.all, "all", false, "Failover all routers")
+	cmd.Flags().StringVar(&f.fromChassis, "from-chassis", "", "Failover all routers currently hosted on the given chassis")
+	cmd.Flags().StringVar(&f.project, "project", "", "Only failover the routers owned by the given Neutron project ID")
+	cmd.Flags().StringVar(&f.toChassis, "to-chassis", "", "Chassis to move the routers to (default: selected by the strategy)")
+	cmd.Flags().StringVar(&f.strategy, "strategy", string(ovnrouter.FailoverStrategySwap), "Failover strategy. One of: (swap, rotate, demote)")
+	cmd.Flags().BoolVar(&f.dryRun, "dry-run", false, "Only print the failover plan without changing any priorities")
+	cmd.Flags().StringVarP(&f.outputFormat, "output", "o", "", "Output format for --dry-run. One of: (json, yaml)")
+	cmd.Flags().StringVar(&f.journal, "journal", "", "Path of the failover journal to write (default: failover-journal-<timestamp>.json)")
+	cmd.Flags().StringVar(&f.revert, "revert", "", "Revert the failover recorded in the given journal")
+	cmd.Flags().IntVar(&f.retries, "retries", 3, "Number of times to recompute and retry a failover when priorities were changed concurrently")
+	cmd.Flags().IntVar(&f.parallelism, "parallelism", 1, "Number of routers to failover concurrently")
 	cmd.Flags().DurationVar(&f.timeout, "timeout", 30*time.Second, "Timeout for each router failover")
+	cmd.Flags().BoolVar(&f.skipHealthCheck, "skip-health-check", false, "Do not check that the chassis routers are moved to are alive and gateway capable")
 
 	// OVN configuration flags
 	cmd.Flags().StringSliceVar(&f.ovnEndpoints, "ovn-endpoints", nil, "OVN database endpoints (default: auto-generated from namespace and statefulset)")
+	cmd.Flags().StringSliceVar(&f.ovnSBEndpoints, "ovn-sb-endpoints", nil, "OVN southbound database endpoints used for chassis health checks and finding the chassis hosting routers (default: auto-generated from namespace and statefulset)")
 	cmd.Flags().StringVar(&f.ovnNamespace, "ovn-namespace", "openstack", "Namespace where OVN is deployed")
 
 	return cmd
@@ -81,21 +172,58 @@ Examples:
 // run executes the failover command
 func (f *FailoverCmd) run(cmd *cobra.Command, args []string) error {
 	// Check arguments
-	if !f.all && len(args) == 0 {
-		return fmt.Errorf("you must specify router UUIDs or use --all flag")
+	if f.revert != "" && (f.all || f.fromChassis != "" || f.toChassis != "" || f.project != "" || len(args) > 0) {
+		return fmt.Errorf("cannot specify routers, --all, --from-chassis, --to-chassis or --project when using --revert flag")
+	}
+
+	if f.revert == "" && !f.all && f.fromChassis == "" && f.project == "" && len(args) == 0 {
+		return fmt.Errorf("you must specify routers or use --all, --from-chassis, --project or --revert flag")
+	}
+
+	if f.all && f.fromChassis != "" {
+		return fmt.Errorf("cannot use --all and --from-chassis flags together")
 	}
 
 	if f.all && len(args) > 0 {
-		return fmt.Errorf("cannot specify router UUIDs when using --all flag")
+		return fmt.Errorf("cannot specify routers when using --all flag")
+	}
+
+	if f.fromChassis != "" && len(args) > 0 {
+		return fmt.Errorf("cannot specify routers when using --from-chassis flag")
+	}
+
+	if f.fromChassis != "" && f.fromChassis == f.toChassis {
+		return fmt.Errorf("--from-chassis and --to-chassis must be different")
 	}
 
-	// Parse router UUIDs from arguments
-	var routerUUIDs []string
-	if !f.all {
+	if f.parallelism < 1 {
+		return fmt.Errorf("--parallelism must be at least 1")
+	}
+
+	if f.retries < 0 {
+		return fmt.Errorf("--retries must not be negative")
+	}
+
+	if f.outputFormat != "" && !f.dryRun {
+		return fmt.Errorf("--output can only be used with --dry-run")
+	}
+
+	if f.outputFormat != "" && f.outputFormat != "json" && f.outputFormat != "yaml" {
+		return fmt.Errorf("unsupported output format: %s", f.outputFormat)
+	}
+
+	strategy := ovnrouter.FailoverStrategy(f.strategy)
+	if err := strategy.Validate(); err != nil {
+		return err
+	}
+
+	// Parse router references from arguments
+	var routerRefs []string
+	if len(args) > 0 {
 		for _, arg := range args {
-			// Support comma-separated UUIDs using K8s helper
-			uuids := resource.SplitResourceArgument(arg)
-			routerUUIDs = append(routerUUIDs, uuids...)
+			// Support comma-separated references using K8s helper
+			refs := resource.SplitResourceArgument(arg)
+			routerRefs = append(routerRefs, refs...)
 		}
 	}
 
@@ -103,6 +231,9 @@ func (f *FailoverCmd) run(cmd *cobra.Command, args []string) error {
 	if len(f.ovnEndpoints) > 0 {
 		f.ovnConfig.Endpoints = f.ovnEndpoints
 	}
+	if len(f.ovnSBEndpoints) > 0 {
+		f.ovnConfig.SBEndpoints = f.ovnSBEndpoints
+	}
 	if f.ovnNamespace != "" {
 		f.ovnConfig.Namespace = f.ovnNamespace
 	}
@@ -115,79 +246,106 @@ func (f *FailoverCmd) run(cmd *cobra.Command, args []string) error {
 	}
 	defer ovnClient.Close()
 
+	// Only move routers to healthy chassis, reverted plans restore recorded
+	// priorities and are not planned against the current chassis health. The
+	// southbound database is also needed to find the chassis hosting routers
+	// when the northbound schema predates the status of router ports.
+	var managerOpts []ovnrouter.ManagerOption
+	healthCheck := !f.skipHealthCheck && f.revert == ""
+	if healthCheck || !ovnrouter.HasPortStatus(ovnClient) {
+		sbClient, err := f.connectToOVNSouthbound(ctx)
+		if err != nil {
+			return err
+		}
+		defer sbClient.Close()
+
+		managerOpts = append(managerOpts, ovnrouter.WithSouthbound(sbClient))
+		if healthCheck {
+			managerOpts = append(managerOpts, ovnrouter.WithHealthChecker(ovnhealth.NewChecker(sbClient)))
+		}
+	}
+
 	// Create router manager
-	routerManager := ovnrouter.NewManager(ovnClient)
+	routerManager := ovnrouter.NewManager(ovnClient, managerOpts...)
 
-	// Get routers to failover
 	var routers []apiv1alpha1.Router
-
-	if f.all {
-		// Get all routers
-		routerList, err := routerManager.List(ctx)
-		if err != nil {
-			return fmt.Errorf("failed to list routers: %w", err)
-		}
-		routers = routerList.Items
-		fmt.Printf("Found %d routers to failover\n", len(routers))
+	var plans []apiv1alpha1.FailoverPlan
+	var failureCount int
+
+	// NOTE: Reverted plans restore recorded priorities and can not be
+	//       recomputed, so they are never retried on conflicts.
+	var opts ovnrouter.FailoverOptions
+	if f.revert != "" {
+		routers, plans, failureCount, err = f.planRevert(ctx, routerManager)
 	} else {
-		// Get specific routers by UUID
-		allRouters, err := routerManager.List(ctx)
-		if err != nil {
-			return fmt.Errorf("failed to list routers: %w", err)
+		opts = ovnrouter.FailoverOptions{
+			TargetChassis: f.toChassis,
+			Strategy:      strategy,
+			Retries:       f.retries,
 		}
+		routers, plans, failureCount, err = f.planFailover(ctx, routerManager, routerRefs, opts)
+	}
+	if err != nil {
+		return err
+	}
 
-		// Create a map for quick lookup
-		routerMap := make(map[string]apiv1alpha1.Router)
-		for _, r := range allRouters.Items {
-			routerMap[string(r.UID)] = r
+	if f.dryRun {
+		if err := f.printPlans(plans); err != nil {
+			return err
 		}
 
-		// Find requested routers
-		for _, uuid := range routerUUIDs {
-			if router, ok := routerMap[uuid]; ok {
-				routers = append(routers, router)
-			} else {
-				return fmt.Errorf("router with UUID %q not found", uuid)
-			}
+		if failureCount > 0 {
+			return fmt.Errorf("%d router(s) failed to plan failover", failureCount)
 		}
+
+		return nil
 	}
 
-	if len(routers) == 0 {
+	if len(plans) == 0 {
+		if failureCount > 0 {
+			return fmt.Errorf("%d router(s) failed to failover", failureCount)
+		}
+
 		fmt.Println("No routers to failover")
 		return nil
 	}
 
-	// Perform failover for each router
-	successCount := 0
-	failureCount := 0
-
-	for _, router := range routers {
-		// Get router name for display
-		routerName := router.Name
-		if router.Name != string(router.UID) {
-			routerName = fmt.Sprintf("%s (%s)", router.Name, router.UID)
-		}
+	// Record the original priorities before changing anything
+	journalPath, journal, err := writeJournal(f.journal, plans)
+	if err != nil {
+		return err
+	}
 
-		fmt.Printf("Triggering failover for router %s... ", routerName)
+	// Perform failover for each router, printing the results in order
+	successCount := 0
+	applied := make([]*apiv1alpha1.FailoverPlan, len(plans))
 
+	forEachOrdered(len(plans), f.parallelism, func(i int) error {
 		// Create a context with timeout for this specific failover
 		failoverCtx, cancel := context.WithTimeout(ctx, f.timeout)
-		err := routerManager.Failover(failoverCtx, &router)
-		cancel()
+		defer cancel()
 
+		var err error
+		applied[i], err = routerManager.ApplyFailoverPlan(failoverCtx, &routers[i], &plans[i], opts)
+		return err
+	}, func(i int, err error) {
 		if err != nil {
-			fmt.Printf("FAILED: %v\n", err)
+			fmt.Printf("Triggering failover for router %s... FAILED: %v\n", routerDisplayName(&routers[i]), err)
 			failureCount++
 			// Continue with other routers even if one fails
 		} else {
-			fmt.Printf("SUCCESS\n")
+			fmt.Printf("Triggering failover for router %s... SUCCESS\n", routerDisplayName(&routers[i]))
 			successCount++
 		}
-	}
+	})
 
 	// Print summary
 	fmt.Printf("\nFailover complete: %d succeeded, %d failed\n", successCount, failureCount)
 
+	if err := updateJournal(journalPath, journal, plans, applied); err != nil {
+		return err
+	}
+
 	if failureCount > 0 {
 		return fmt.Errorf("%d router(s) failed to failover", failureCount)
 	}
@@ -195,42 +353,234 @@ func (f *FailoverCmd) run(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-// connectToOVN establishes connection to OVN database
-func (f *FailoverCmd) connectToOVN(ctx context.Context) (client.Client, error) {
-	// Get database model
-	dbModel, err := model.NewClientDBModel("OVN_Northbound", map[string]model.Model{
-		nbdb.GatewayChassisTable:    &nbdb.GatewayChassis{},
-		nbdb.HAChassisGroupTable:    &nbdb.HAChassisGroup{},
-		nbdb.HAChassisTable:         &nbdb.HAChassis{},
-		nbdb.LogicalRouterPortTable: &nbdb.LogicalRouterPort{},
-		nbdb.LogicalRouterTable:     &nbdb.LogicalRouter{},
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to get database model: %w", err)
+// writeJournal writes a journal recording the original priorities of the
+// plans before they are applied, to the given path or to a timestamped file in
+// the current directory, and returns the path it was written to
+func writeJournal(path string, plans []apiv1alpha1.FailoverPlan) (string, *apiv1alpha1.FailoverJournal, error) {
+	if path == "" {
+		path = fmt.Sprintf("failover-journal-%s.json", time.Now().UTC().Format("20060102T150405Z"))
+	}
+
+	journal := ovnrouter.NewFailoverJournal(plans)
+	if err := ovnrouter.WriteFailoverJournal(path, journal); err != nil {
+		return "", nil, err
+	}
+	fmt.Printf("Wrote failover journal to %s\n", path)
+
+	return path, journal, nil
+}
+
+// updateJournal rewrites the journal written by writeJournal with the plans
+// returned by ApplyFailoverPlan, if any of them differs from the recorded one
+// because it was recomputed after a conflict or never applied
+func updateJournal(path string, journal *apiv1alpha1.FailoverJournal, plans []apiv1alpha1.FailoverPlan, applied []*apiv1alpha1.FailoverPlan) error {
+	changed := false
+	appliedPlans := []apiv1alpha1.FailoverPlan{}
+	for i := range plans {
+		if applied[i] != &plans[i] {
+			changed = true
+		}
+
+		if applied[i] != nil {
+			appliedPlans = append(appliedPlans, *applied[i])
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	journal.Plans = appliedPlans
+	if err := ovnrouter.UpdateFailoverJournal(path, journal); err != nil {
+		return err
+	}
+	fmt.Printf("Updated failover journal %s with the applied plans\n", path)
+
+	return nil
+}
+
+// planFailover selects the routers to failover and computes their failover plans,
+// reporting the routers which could not be planned
+func (f *FailoverCmd) planFailover(ctx context.Context, routerManager *ovnrouter.Manager, routerRefs []string, opts ovnrouter.FailoverOptions) ([]apiv1alpha1.Router, []apiv1alpha1.FailoverPlan, int, error) {
+	var selected []apiv1alpha1.Router
+
+	// NOTE: The project is applied before resolving the routers, so that
+	//       names only need to be unique within the project.
+	inProject := func(routers []apiv1alpha1.Router) []apiv1alpha1.Router {
+		if f.project == "" {
+			return routers
+		}
+
+		return ovnrouter.FilterByProject(routers, f.project)
+	}
+
+	switch {
+	case f.fromChassis != "":
+		// Get routers hosted on the chassis being drained
+		routerList, err := routerManager.ListByHostingChassis(ctx, f.fromChassis)
+		if err != nil {
+			return nil, nil, 0, fmt.Errorf("failed to list routers hosted on chassis %q: %w", f.fromChassis, err)
+		}
+		selected = inProject(routerList.Items)
+		f.printf("Found %d routers hosted on chassis %q to failover\n", len(selected), f.fromChassis)
+	case len(routerRefs) > 0:
+		// Get specific routers by UUID, name or UUID prefix
+		allRouters, err := routerManager.List(ctx)
+		if err != nil {
+			return nil, nil, 0, fmt.Errorf("failed to list routers: %w", err)
+		}
+
+		selected, err = ovnrouter.Resolve(inProject(allRouters.Items), routerRefs)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+	default:
+		// Get all routers, or all routers of the project
+		routerList, err := routerManager.List(ctx)
+		if err != nil {
+			return nil, nil, 0, fmt.Errorf("failed to list routers: %w", err)
+		}
+		selected = inProject(routerList.Items)
+		if f.project != "" {
+			f.printf("Found %d routers of project %q to failover\n", len(selected), f.project)
+		} else {
+			f.printf("Found %d routers to failover\n", len(selected))
+		}
+	}
+
+	var routers []apiv1alpha1.Router
+	var plans []apiv1alpha1.FailoverPlan
+	failureCount := 0
+
+	for _, router := range selected {
+		plan, err := routerManager.PlanFailover(ctx, &router, opts)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Planning failover for router %s... FAILED: %v\n", routerDisplayName(&router), err)
+			failureCount++
+			continue
+		}
+
+		routers = append(routers, router)
+		plans = append(plans, *plan)
 	}
 
-	// Get endpoints
-	endpoints := f.ovnConfig.GetNBEndpoints()
+	return routers, plans, failureCount, nil
+}
 
-	// Create client
-	ovnClient, err := client.NewOVSDBClient(
-		dbModel,
-		client.WithEndpoint(strings.Join(endpoints, ",")),
-		client.WithLeaderOnly(true),
-	)
+// planRevert reads the journal to revert and computes the plans restoring the
+// original priorities, reporting the routers which no longer exist
+func (f *FailoverCmd) planRevert(ctx context.Context, routerManager *ovnrouter.Manager) ([]apiv1alpha1.Router, []apiv1alpha1.FailoverPlan, int, error) {
+	journal, err := ovnrouter.ReadFailoverJournal(f.revert)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create OVN client: %w", err)
+		return nil, nil, 0, err
+	}
+	f.printf("Found %d routers to revert in journal %s\n", len(journal.Plans), f.revert)
+
+	var routers []apiv1alpha1.Router
+	var plans []apiv1alpha1.FailoverPlan
+	failureCount := 0
+
+	for _, plan := range journal.Plans {
+		router, err := routerManager.GetByUUID(ctx, plan.UID)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Planning revert for router %s... FAILED: %v\n", plan.UID, err)
+			failureCount++
+			continue
+		}
+
+		routers = append(routers, *router)
+		plans = append(plans, *ovnrouter.ReverseFailoverPlan(&plan))
+	}
+
+	return routers, plans, failureCount, nil
+}
+
+// routerDisplayName returns the name of the router for display, including its
+// UUID when the router has a name
+func routerDisplayName(router *apiv1alpha1.Router) string {
+	if router.Name != string(router.UID) {
+		return fmt.Sprintf("%s (%s)", router.Name, router.UID)
+	}
+
+	return router.Name
+}
+
+// printPlans prints the failover plans as a table or in the requested output format
+func (f *FailoverCmd) printPlans(plans []apiv1alpha1.FailoverPlan) error {
+	if f.outputFormat != "" {
+		planList := &apiv1alpha1.FailoverPlanList{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "FailoverPlanList",
+				APIVersion: "atmosphere.vexxhost.com/v1alpha1",
+			},
+			Items: plans,
+		}
+		if planList.Items == nil {
+			planList.Items = []apiv1alpha1.FailoverPlan{}
+		}
+
+		return printObject(planList, os.Stdout, f.outputFormat)
 	}
 
-	// Connect
-	if err := ovnClient.Connect(ctx); err != nil {
-		return nil, fmt.Errorf("failed to connect to OVN: %w", err)
+	table := &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Table",
+			APIVersion: "meta.k8s.io/v1",
+		},
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "UUID", Type: "string", Description: "Router UUID"},
+			{Name: "NAME", Type: "string", Description: "Router name from Neutron"},
+			{Name: "CURRENT", Type: "string", Description: "Chassis currently hosting the router"},
+			{Name: "TARGET", Type: "string", Description: "Chassis which will host the router"},
+			{Name: "PRIORITY-CHANGES", Type: "string", Description: "Priority changes of the gateway chassis"},
+		},
 	}
 
-	// Monitor the database
-	if _, err := ovnClient.MonitorAll(ctx); err != nil {
-		return nil, fmt.Errorf("failed to monitor OVN database: %w", err)
+	for _, plan := range plans {
+		changes := []string{}
+		for _, change := range plan.Spec.Changes {
+			changes = append(changes, fmt.Sprintf("%s:%d->%d", change.ChassisName, change.OldPriority, change.NewPriority))
+		}
+
+		changeList := "<none>"
+		if len(changes) > 0 {
+			changeList = strings.Join(changes, ",")
+		}
+
+		table.Rows = append(table.Rows, metav1.TableRow{
+			Cells: []interface{}{
+				string(plan.UID),
+				plan.Name,
+				plan.Spec.CurrentChassis,
+				plan.Spec.TargetChassis,
+				changeList,
+			},
+		})
 	}
 
-	return ovnClient, nil
+	printer := printers.NewTablePrinter(printers.PrintOptions{})
+	return printer.PrintObj(table, os.Stdout)
+}
+
+// printf prints progress messages, unless a structured output format is requested
+func (f *FailoverCmd) printf(format string, a ...interface{}) {
+	if f.outputFormat != "" {
+		return
+	}
+
+	fmt.Printf(format, a...)
+}
+
+// connectToOVNSouthbound establishes connection to the OVN southbound database
+// used to check the health of chassis and find the chassis hosting routers
+func (f *FailoverCmd) connectToOVNSouthbound(ctx context.Context) (client.Client, error) {
+	tables := ovnhealth.Tables()
+	maps.Copy(tables, ovnrouter.SouthboundTables())
+
+	return connectToOVNDatabase(ctx, "OVN_Southbound", f.ovnConfig.GetSBEndpoints(), tables)
+}
+
+// connectToOVN establishes connection to OVN database
+func (f *FailoverCmd) connectToOVN(ctx context.Context) (client.Client, error) {
+	return connectToOVNDatabase(ctx, "OVN_Northbound", f.ovnConfig.GetNBEndpoints(), ovnrouter.Tables(), client.WithLeaderOnly(true))
 }
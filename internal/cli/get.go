@@ -5,12 +5,16 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/signal"
+	"sort"
 	"strings"
+	"syscall"
 
 	"github.com/ovn-org/libovsdb/client"
 	"github.com/ovn-org/libovsdb/model"
-	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
@@ -26,23 +30,35 @@ type GetCmd struct {
 	registry    *resources.Registry
 	ovnConfig   *resources.OVNConfig
 
+	// flagResources maps the name of every resource specific flag to the
+	// resource declaring it
+	flagResources map[string]resources.Resource
+
 	// Command options
-	outputFormat string
-	noHeaders    bool
-	ovnEndpoints []string
-	ovnNamespace string
+	printFlags     *genericclioptions.PrintFlags
+	sortBy         string
+	labelSelector  string
+	fieldSelector  string
+	noHeaders      bool
+	watch          bool
+	watchEvents    bool
+	ovnEndpoints   []string
+	ovnSBEndpoints []string
+	ovnNamespace   string
 }
 
 // NewGetCommand creates a new get command
 func NewGetCommand(configFlags *genericclioptions.ConfigFlags) *cobra.Command {
 	g := &GetCmd{
-		configFlags: configFlags,
-		registry:    resources.NewRegistry(),
-		ovnConfig:   resources.DefaultOVNConfig(),
+		configFlags:   configFlags,
+		registry:      resources.NewRegistry(),
+		ovnConfig:     resources.DefaultOVNConfig(),
+		flagResources: map[string]resources.Resource{},
+		printFlags:    genericclioptions.NewPrintFlags(""),
 	}
 
 	// Register all resources
-	g.registerResources()
+	registerResources(g.registry)
 
 	cmd := &cobra.Command{
 		Use:   "get [resource] [uuid...]",
@@ -52,25 +68,63 @@ func NewGetCommand(configFlags *genericclioptions.ConfigFlags) *cobra.Command {
 	}
 
 	// Add flags
-	cmd.Flags().StringVarP(&g.outputFormat, "output", "o", "", "Output format. One of: (json, yaml, wide)")
+	g.printFlags.JSONYamlPrintFlags.AddFlags(cmd)
+	g.printFlags.TemplatePrinterFlags.AddFlags(cmd)
+	cmd.Flags().StringVarP(g.printFlags.OutputFormat, "output", "o", "", fmt.Sprintf("Output format. One of: (%s)", strings.Join(g.allowedFormats(), ", ")))
+	g.printFlags.OutputFlagSpecified = func() bool {
+		return cmd.Flag("output").Changed
+	}
+	cmd.Flags().StringVarP(&g.labelSelector, "selector", "l", "", "Selector (label query) to filter on OVN external_ids, supports '=', '==', '!=', 'in', 'notin', 'key' and '!key' (e.g. -l neutron:availability_zone_hints=az1)")
+	cmd.Flags().StringVar(&g.fieldSelector, "field-selector", "", "Selector (field query) to filter on any field of the resources, supports '=', '==', and '!=' (e.g. --field-selector status.agent=net-1)")
+	cmd.Flags().StringVar(&g.sortBy, "sort-by", "", "If non-empty, sort list types using this field specification. The field specification is expressed as a JSONPath expression (e.g. '{.status.agent}')")
 	cmd.Flags().BoolVar(&g.noHeaders, "no-headers", false, "When using the default output format, don't print headers")
+	cmd.Flags().BoolVarP(&g.watch, "watch", "w", false, "After listing the requested resources, watch for changes")
+	cmd.Flags().BoolVar(&g.watchEvents, "output-watch-events", false, "Output watch event objects when --watch is used, existing objects are output as initial ADDED events")
+
+	// Resource specific flags
+	for _, name := range g.registry.List() {
+		if resource, ok := g.registry.Get(name); ok {
+			if flagsResource, ok := resource.(resources.FlagsResource); ok {
+				flags := pflag.NewFlagSet(name, pflag.ContinueOnError)
+				flagsResource.AddFlags(flags)
+				flags.VisitAll(func(flag *pflag.Flag) {
+					g.flagResources[flag.Name] = resource
+				})
+				cmd.Flags().AddFlagSet(flags)
+			}
+		}
+	}
 
 	// OVN configuration flags
 	cmd.Flags().StringSliceVar(&g.ovnEndpoints, "ovn-endpoints", nil, "OVN database endpoints (default: auto-generated from namespace and statefulset)")
+	cmd.Flags().StringSliceVar(&g.ovnSBEndpoints, "ovn-sb-endpoints", nil, "OVN southbound database endpoints (default: auto-generated from namespace and statefulset)")
 	cmd.Flags().StringVar(&g.ovnNamespace, "ovn-namespace", "openstack", "Namespace where OVN is deployed")
 
 	return cmd
 }
 
 // registerResources registers all available resources
-func (g *GetCmd) registerResources() {
+func registerResources(registry *resources.Registry) {
 	// Register router resource
-	g.registry.Register(&resources.RouterResource{})
+	registry.Register(&resources.RouterResource{})
+
+	// Register chassis resource
+	registry.Register(&resources.ChassisResource{})
+
+	// Register network resource
+	registry.Register(&resources.NetworkResource{})
 
-	// Future resources can be registered here:
-	// g.registry.Register(&resources.PortResource{})
-	// g.registry.Register(&resources.SwitchResource{})
-	// g.registry.Register(&resources.LoadBalancerResource{})
+	// Register port resource
+	registry.Register(&resources.PortResource{})
+
+	// Register load balancer resource
+	registry.Register(&resources.LoadBalancerResource{})
+
+	// Register floating IP resource
+	registry.Register(&resources.FloatingIPResource{})
+
+	// Register security group resource
+	registry.Register(&resources.SecurityGroupResource{})
 }
 
 // getLongDescription builds the long description with available resources
@@ -99,7 +153,56 @@ Examples:
   
   # Get multiple routers using comma-separated UUIDs
   atmosphere get routers/uuid1,uuid2,uuid3
+
+  # Get a router by its Neutron name or a UUID prefix
+  atmosphere get router router-1
+  atmosphere get router 550e8400
+
+  # List the routers of a project
+  atmosphere get routers --project 3a9c1b2e4d5f6a7b8c9d0e1f2a3b4c5d
   
+  # List all networks with their provider network details
+  atmosphere get networks -o wide
+
+  # List the ports of a server
+  atmosphere get ports --device-id 9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d
+
+  # List the ports attached to a network
+  atmosphere get ports --network 5b3c1a2e-7f0d-4c1e-9b8a-2d6f4e3c1a0b
+
+  # List all Octavia load balancers with the health of their members
+  atmosphere get loadbalancers
+
+  # Show which chassis is serving a floating IP
+  atmosphere get floatingips 203.0.113.10 -o wide
+
+  # Show the ACLs of a security group
+  atmosphere get securitygroups 7d5b3f1e-2a4c-4e6f-8b1d-3c5e7a9b1d2f -o wide
+
+  # List all chassis with their liveness and number of active routers
+  atmosphere get chassis
+
+  # List the routers hosted on a chassis
+  atmosphere get routers --field-selector status.agent=net-1
+
+  # List the routers scheduled in an availability zone
+  atmosphere get routers -l neutron:availability_zone_hints=az1
+
+  # List the routers scheduled in either of two availability zones
+  atmosphere get routers -l 'neutron:availability_zone_hints in (az1,az2)'
+
+  # Watch routers move between chassis during a failover
+  atmosphere get routers --watch
+
+  # Print the external IPs of a router
+  atmosphere get router 550e8400-e29b-41d4-a716-446655440000 -o jsonpath='{.items[0].status.externalIPs}'
+
+  # Print routers with custom columns, sorted by their hosting agent
+  atmosphere get routers -o custom-columns=NAME:.metadata.name,AGENT:.status.agent --sort-by .status.agent
+
+  # Print the type and name of every router
+  atmosphere get routers -o name
+
   # Output in JSON format
   atmosphere get routers -o json
   
@@ -121,7 +224,7 @@ func (g *GetCmd) run(cmd *cobra.Command, args []string) error {
 	}
 
 	// Parse resource type and names (supporting both "resource name" and "resource/name" formats)
-	resourceType, resourceNames, err := g.parseResourceArgs(args)
+	resourceType, resourceNames, err := parseResourceArgs(args)
 	if err != nil {
 		return err
 	}
@@ -133,24 +236,49 @@ func (g *GetCmd) run(cmd *cobra.Command, args []string) error {
 			resourceType, strings.Join(g.registry.List(), ", "))
 	}
 
+	if err := g.checkResourceFlags(cmd, resource); err != nil {
+		return err
+	}
+
+	selector, err := resources.NewSelector(g.labelSelector, g.fieldSelector)
+	if err != nil {
+		return err
+	}
+
 	// Update OVN config with command line options
 	if len(g.ovnEndpoints) > 0 {
 		g.ovnConfig.Endpoints = g.ovnEndpoints
 	}
+	if len(g.ovnSBEndpoints) > 0 {
+		g.ovnConfig.SBEndpoints = g.ovnSBEndpoints
+	}
 	if g.ovnNamespace != "" {
 		g.ovnConfig.Namespace = g.ovnNamespace
 	}
 
 	// Connect to OVN
 	ctx := context.Background()
-	ovnClient, err := g.connectToOVN(ctx)
+	ovnClient, err := g.connectToOVN(ctx, resource)
 	if err != nil {
 		return err
 	}
 	defer ovnClient.Close()
 
+	clients := &resources.Clients{NB: ovnClient}
+
+	// Connect to the southbound database if the resource needs it
+	if tables := southboundTables(resource, ovnClient); tables != nil {
+		sbClient, err := g.connectToOVNSouthbound(ctx, tables)
+		if err != nil {
+			return err
+		}
+		defer sbClient.Close()
+
+		clients.SB = sbClient
+	}
+
 	// Fetch the resources
-	data, err := resource.List(ctx, ovnClient, resourceNames)
+	data, err := g.list(ctx, resource, clients, resourceNames, selector)
 	if err != nil {
 		return err
 	}
@@ -160,72 +288,153 @@ func (g *GetCmd) run(cmd *cobra.Command, args []string) error {
 		Out: os.Stdout,
 	}
 
-	switch g.outputFormat {
-	case "json", "yaml":
-		// Print as JSON/YAML
-		return g.printObject(data, streams.Out, g.outputFormat)
-	case "wide":
-		// Get the wide table representation
-		if tableResource, ok := resource.(interface {
-			GetWideTable(runtime.Object) (*metav1.Table, error)
-		}); ok {
-			table, err := tableResource.GetWideTable(data)
-			if err != nil {
-				return err
-			}
-			return g.printTable(table, streams.Out)
+	if g.watch {
+		ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		return g.watchResource(ctx, resource, clients, resourceNames, selector, data, streams.Out)
+	}
+
+	return g.printResult(resource, data, streams.Out)
+}
+
+// checkResourceFlags returns an error if a resource specific flag declared by
+// another resource than the one to get was given, since it would be ignored
+func (g *GetCmd) checkResourceFlags(cmd *cobra.Command, resource resources.Resource) error {
+	var err error
+	cmd.Flags().Visit(func(flag *pflag.Flag) {
+		owner, ok := g.flagResources[flag.Name]
+		if ok && owner != resource && err == nil {
+			err = fmt.Errorf("flag --%s is only supported by %s, not %s", flag.Name, owner.Name(), resource.Name())
 		}
-		// Fallback to regular table if no wide implementation
-		table, err := resource.GetTable(data)
-		if err != nil {
-			return err
+	})
+
+	return err
+}
+
+// list fetches the resources, keeping the ones matching the selector sorted as
+// requested
+func (g *GetCmd) list(ctx context.Context, resource resources.Resource, clients *resources.Clients, names []string, selector *resources.Selector) (runtime.Object, error) {
+	data, err := resource.List(ctx, clients, names)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := selector.Filter(data); err != nil {
+		return nil, err
+	}
+
+	if g.sortBy != "" {
+		if err := sortList(data, g.sortBy); err != nil {
+			return nil, err
 		}
-		return g.printTable(table, streams.Out)
-	default:
-		// Get the regular table representation
-		table, err := resource.GetTable(data)
+	}
+
+	return data, nil
+}
+
+// allowedFormats returns the output formats supported by the get command
+func (g *GetCmd) allowedFormats() []string {
+	formats := append([]string{"wide", "custom-columns"}, g.printFlags.AllowedFormats()...)
+	sort.Strings(formats)
+
+	return formats
+}
+
+// outputFormat returns the requested output format
+func (g *GetCmd) outputFormat() string {
+	return *g.printFlags.OutputFormat
+}
+
+// isTableOutput returns true if the resources are printed as a table
+func (g *GetCmd) isTableOutput() bool {
+	format := g.outputFormat()
+	if format == "" {
+		// NOTE: The --template flag implies go-template output when no
+		//       output format is given, like kubectl does.
+		template := g.printFlags.TemplatePrinterFlags.TemplateArgument
+		return template == nil || *template == ""
+	}
+
+	return format == "wide" || strings.HasPrefix(format, customColumnsPrefix)
+}
+
+// printResult prints the resources in the requested output format
+func (g *GetCmd) printResult(resource resources.Resource, data runtime.Object, out io.Writer) error {
+	if g.isTableOutput() {
+		table, err := g.getTable(resource, data)
 		if err != nil {
 			return err
 		}
-		return g.printTable(table, streams.Out)
+		return g.printTable(table, out)
 	}
-}
 
-// connectToOVN establishes connection to OVN database
-func (g *GetCmd) connectToOVN(ctx context.Context) (client.Client, error) {
-	// Get database model
-	dbModel, err := model.NewClientDBModel("OVN_Northbound", map[string]model.Model{
-		nbdb.GatewayChassisTable:    &nbdb.GatewayChassis{},
-		nbdb.LogicalRouterTable:     &nbdb.LogicalRouter{},
-		nbdb.LogicalRouterPortTable: &nbdb.LogicalRouterPort{},
-	})
+	printer, err := g.printFlags.ToPrinter()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get database model: %w", err)
+		return err
 	}
 
-	// Get endpoints
-	endpoints := g.ovnConfig.GetNBEndpoints()
+	// NOTE: The name printer does not support typed lists, so the resources
+	//       are printed one by one like kubectl does.
+	if g.outputFormat() == "name" {
+		items, err := meta.ExtractList(data)
+		if err != nil {
+			return fmt.Errorf("failed to extract resources from list: %w", err)
+		}
 
-	// Create client
-	ovnClient, err := client.NewOVSDBClient(
-		dbModel,
-		client.WithEndpoint(strings.Join(endpoints, ",")),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create OVN client: %w", err)
+		for _, item := range items {
+			if err := printer.PrintObj(item, out); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return printer.PrintObj(data, out)
+}
+
+// getTable returns the table representation of the resources, using the wide
+// representation when requested and implemented by the resource
+func (g *GetCmd) getTable(resource resources.Resource, data runtime.Object) (*metav1.Table, error) {
+	format := g.outputFormat()
+
+	if spec, ok := strings.CutPrefix(format, customColumnsPrefix); ok {
+		return customColumnsTable(spec, data)
 	}
 
-	// Connect
-	if err := ovnClient.Connect(ctx); err != nil {
-		return nil, fmt.Errorf("failed to connect to OVN: %w", err)
+	if format == "wide" {
+		// Get the wide table representation
+		if tableResource, ok := resource.(interface {
+			GetWideTable(runtime.Object) (*metav1.Table, error)
+		}); ok {
+			return tableResource.GetWideTable(data)
+		}
 	}
 
-	// Monitor the database
-	if _, err := ovnClient.MonitorAll(ctx); err != nil {
-		return nil, fmt.Errorf("failed to monitor OVN database: %w", err)
+	// Fallback to regular table if no wide implementation
+	return resource.GetTable(data)
+}
+
+// connectToOVN establishes connection to OVN database
+func (g *GetCmd) connectToOVN(ctx context.Context, resource resources.Resource) (client.Client, error) {
+	return connectToOVNDatabase(ctx, "OVN_Northbound", g.ovnConfig.GetNBEndpoints(), resource.NorthboundTables())
+}
+
+// southboundTables returns the southbound tables needed by the resource with
+// the northbound client, or nil if it does not need the southbound database
+func southboundTables(resource resources.Resource, nb client.Client) map[string]model.Model {
+	sbResource, ok := resource.(resources.SouthboundResource)
+	if !ok {
+		return nil
 	}
 
-	return ovnClient, nil
+	return sbResource.SouthboundTables(nb)
+}
+
+// connectToOVNSouthbound establishes connection to the OVN southbound database
+func (g *GetCmd) connectToOVNSouthbound(ctx context.Context, tables map[string]model.Model) (client.Client, error) {
+	return connectToOVNDatabase(ctx, "OVN_Southbound", g.ovnConfig.GetSBEndpoints(), tables)
 }
 
 // printTable prints a table using the table printer
@@ -238,12 +447,12 @@ func (g *GetCmd) printTable(table *metav1.Table, out io.Writer) error {
 }
 
 // parseResourceArgs parses command arguments supporting both "resource name" and "resource/name" formats
-func (g *GetCmd) parseResourceArgs(args []string) (string, []string, error) {
+func parseResourceArgs(args []string) (string, []string, error) {
 	if len(args) == 0 {
 		return "", nil, fmt.Errorf("no arguments provided")
 	}
 
-	firstArg := strings.ToLower(args[0])
+	firstArg := args[0]
 
 	// Check if the first argument contains a slash (resource/name format)
 	if strings.Contains(firstArg, "/") {
@@ -253,7 +462,9 @@ func (g *GetCmd) parseResourceArgs(args []string) (string, []string, error) {
 			return "", nil, fmt.Errorf("arguments in resource/name form may not have more than one slash")
 		}
 
-		resourceType := parts[0]
+		// NOTE: Only the resource type is case insensitive, names such as the
+		//       Neutron name of a router are matched as given.
+		resourceType := strings.ToLower(parts[0])
 		resourceName := parts[1]
 
 		if len(resourceType) == 0 || len(resourceName) == 0 {
@@ -271,7 +482,7 @@ func (g *GetCmd) parseResourceArgs(args []string) (string, []string, error) {
 	}
 
 	// Handle space-separated format (resource name1 name2 ...)
-	resourceType := firstArg
+	resourceType := strings.ToLower(firstArg)
 	var resourceNames []string
 
 	if len(args) > 1 {
@@ -287,7 +498,7 @@ func (g *GetCmd) parseResourceArgs(args []string) (string, []string, error) {
 }
 
 // printObject prints data in JSON or YAML format
-func (g *GetCmd) printObject(obj runtime.Object, out io.Writer, format string) error {
+func printObject(obj runtime.Object, out io.Writer, format string) error {
 	var printer printers.ResourcePrinter
 	switch format {
 	case "json":
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vexxhost/atmosphere/internal/cli/resources"
+)
+
+func TestRegisterResources_Southbound(t *testing.T) {
+	registry := resources.NewRegistry()
+	registerResources(registry)
+
+	for _, name := range []string{"routers", "chassis", "floatingips", "loadbalancers", "ports"} {
+		t.Run(name, func(t *testing.T) {
+			resource, ok := registry.Get(name)
+			require.True(t, ok)
+
+			assert.Implements(t, (*resources.SouthboundResource)(nil), resource)
+		})
+	}
+}
+
+func TestParseResourceArgs(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         []string
+		resourceType string
+		names        []string
+		err          string
+	}{
+		{
+			name:         "resource only",
+			args:         []string{"Routers"},
+			resourceType: "routers",
+		},
+		{
+			name:         "separate names",
+			args:         []string{"ROUTER", "MyRouter", "a,B"},
+			resourceType: "router",
+			names:        []string{"MyRouter", "a", "B"},
+		},
+		{
+			name:         "slash notation",
+			args:         []string{"Router/MyRouter"},
+			resourceType: "router",
+			names:        []string{"MyRouter"},
+		},
+		{
+			name:         "slash notation with multiple names",
+			args:         []string{"router/MyRouter,Other"},
+			resourceType: "router",
+			names:        []string{"MyRouter", "Other"},
+		},
+		{
+			name: "no arguments",
+			err:  "no arguments provided",
+		},
+		{
+			name: "multiple slashes",
+			args: []string{"router/a/b"},
+			err:  "may not have more than one slash",
+		},
+		{
+			name: "missing name",
+			args: []string{"router/"},
+			err:  "must have a single resource and name",
+		},
+		{
+			name: "additional arguments",
+			args: []string{"router/a", "b"},
+			err:  "no need to specify additional arguments",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resourceType, names, err := parseResourceArgs(tt.args)
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.resourceType, resourceType)
+			assert.Equal(t, tt.names, names)
+		})
+	}
+}
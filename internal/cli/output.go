@@ -0,0 +1,217 @@
+package cli
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// customColumnsPrefix is the prefix of the output format printing the columns
+// given by the user
+const customColumnsPrefix = "custom-columns="
+
+// jsonPathRegexp matches the JSONPath expressions accepted by --sort-by and
+// custom columns, with or without the surrounding braces and leading dot
+var jsonPathRegexp = regexp.MustCompile(`^\{\.?([^{}]+)\}$|^\.?([^{}]+)$`)
+
+// relaxedJSONPathExpression turns a field path such as `.status.agent` into
+// the `{.status.agent}` JSONPath template, like kubectl does
+func relaxedJSONPathExpression(expression string) (string, error) {
+	submatches := jsonPathRegexp.FindStringSubmatch(expression)
+	if submatches == nil {
+		return "", fmt.Errorf("unexpected path string %q, expected a 'name1.name2' or '.name1.name2' or '{name1.name2}' or '{.name1.name2}'", expression)
+	}
+
+	fieldSpec := submatches[1]
+	if fieldSpec == "" {
+		fieldSpec = submatches[2]
+	}
+
+	return fmt.Sprintf("{.%s}", fieldSpec), nil
+}
+
+// parseJSONPath parses a relaxed JSONPath expression
+func parseJSONPath(name, expression string) (*jsonpath.JSONPath, error) {
+	template, err := relaxedJSONPathExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := jsonpath.New(name).AllowMissingKeys(true)
+	if err := parser.Parse(template); err != nil {
+		return nil, fmt.Errorf("failed to parse JSONPath %q: %w", expression, err)
+	}
+
+	return parser, nil
+}
+
+// findJSONPath returns the values matched by the parser in the object
+func findJSONPath(parser *jsonpath.JSONPath, obj runtime.Object) ([]reflect.Value, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %T: %w", obj, err)
+	}
+
+	results, err := parser.FindResults(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []reflect.Value
+	for _, result := range results {
+		values = append(values, result...)
+	}
+
+	return values, nil
+}
+
+// customColumnsTable builds a table with the columns given as a comma separated
+// list of `HEADER:JSONPATH` pairs for every resource in the list
+func customColumnsTable(spec string, list runtime.Object) (*metav1.Table, error) {
+	if spec == "" {
+		return nil, fmt.Errorf("custom-columns format specified but no custom columns given")
+	}
+
+	var columns []metav1.TableColumnDefinition
+	var parsers []*jsonpath.JSONPath
+	for _, column := range strings.Split(spec, ",") {
+		header, expression, ok := strings.Cut(column, ":")
+		if !ok {
+			return nil, fmt.Errorf("unexpected custom-columns spec: %s, expected <header>:<json-path-expr>", column)
+		}
+
+		parser, err := parseJSONPath(header, expression)
+		if err != nil {
+			return nil, err
+		}
+
+		columns = append(columns, metav1.TableColumnDefinition{Name: header, Type: "string"})
+		parsers = append(parsers, parser)
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract resources from list: %w", err)
+	}
+
+	rows := []metav1.TableRow{}
+	for _, item := range items {
+		cells := make([]interface{}, 0, len(parsers))
+		for _, parser := range parsers {
+			values, err := findJSONPath(parser, item)
+			if err != nil {
+				return nil, err
+			}
+
+			cell := "<none>"
+			if len(values) > 0 {
+				formatted := make([]string, 0, len(values))
+				for _, value := range values {
+					formatted = append(formatted, fmt.Sprint(value.Interface()))
+				}
+				cell = strings.Join(formatted, ",")
+			}
+
+			cells = append(cells, cell)
+		}
+
+		rows = append(rows, metav1.TableRow{Cells: cells})
+	}
+
+	return &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Table",
+			APIVersion: "meta.k8s.io/v1",
+		},
+		ColumnDefinitions: columns,
+		Rows:              rows,
+	}, nil
+}
+
+// sortList sorts the resources of a list in place by the value of the JSONPath
+// expression, resources without a value are sorted first
+func sortList(list runtime.Object, expression string) error {
+	parser, err := parseJSONPath("sort-by", expression)
+	if err != nil {
+		return err
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return fmt.Errorf("failed to extract resources from list: %w", err)
+	}
+
+	keys := make([]interface{}, len(items))
+	for i, item := range items {
+		values, err := findJSONPath(parser, item)
+		if err != nil {
+			return err
+		}
+
+		if len(values) > 0 {
+			keys[i] = values[0].Interface()
+		}
+	}
+
+	indexes := make([]int, len(items))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return lessSortKey(keys[indexes[i]], keys[indexes[j]])
+	})
+
+	sorted := make([]runtime.Object, len(items))
+	for i, index := range indexes {
+		sorted[i] = items[index]
+	}
+
+	return meta.SetList(list, sorted)
+}
+
+// lessSortKey compares two values found by --sort-by, missing values are sorted
+// first, followed by numbers compared by value and everything else compared by
+// its string representation
+func lessSortKey(a, b interface{}) bool {
+	switch {
+	case a == nil:
+		return b != nil
+	case b == nil:
+		return false
+	}
+
+	// NOTE: Numbers are never compared with strings, which would make the
+	//       order depend on the order of the comparisons.
+	af, aok := toFloat(a)
+	bf, bok := toFloat(b)
+	switch {
+	case aok && bok:
+		return af < bf
+	case aok != bok:
+		return aok
+	}
+
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
+
+// toFloat converts a number decoded from an unstructured object to a float
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
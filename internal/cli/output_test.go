@@ -0,0 +1,171 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+)
+
+// testRouterList returns a list of routers with the given agents and number
+// of NATs, an empty agent is omitted from the router
+func testRouterList(agents []string, nats []int) *apiv1alpha1.RouterList {
+	list := &apiv1alpha1.RouterList{}
+	for i, agent := range agents {
+		list.Items = append(list.Items, apiv1alpha1.Router{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: string(rune('a' + i)),
+				UID:  types.UID(string(rune('a' + i))),
+			},
+			Status: apiv1alpha1.RouterStatus{
+				Agent: agent,
+				NATs:  nats[i],
+			},
+		})
+	}
+
+	return list
+}
+
+// routerNames returns the names of the routers of the list in order
+func routerNames(list *apiv1alpha1.RouterList) []string {
+	var names []string
+	for _, router := range list.Items {
+		names = append(names, router.Name)
+	}
+
+	return names
+}
+
+func TestSortList(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		expected   []string
+		err        string
+	}{
+		{
+			name:       "strings with missing values first",
+			expression: ".status.agent",
+			expected:   []string{"b", "d", "c", "a"},
+		},
+		{
+			name:       "numbers by value",
+			expression: "{.status.nats}",
+			expected:   []string{"c", "a", "d", "b"},
+		},
+		{
+			name:       "missing field keeps the order",
+			expression: ".status.unknown",
+			expected:   []string{"a", "b", "c", "d"},
+		},
+		{
+			name:       "invalid expression",
+			expression: "{.status.agent",
+			err:        "unexpected path string",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := testRouterList([]string{"net-3", "", "net-2", ""}, []int{9, 10, 2, 9})
+
+			err := sortList(list, tt.expression)
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, routerNames(list))
+		})
+	}
+}
+
+func TestLessSortKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        interface{}
+		b        interface{}
+		expected bool
+	}{
+		{name: "nil before value", a: nil, b: "a", expected: true},
+		{name: "value after nil", a: "a", b: nil, expected: false},
+		{name: "nil equal to nil", a: nil, b: nil, expected: false},
+		{name: "numbers by value", a: int64(9), b: int64(10), expected: true},
+		{name: "integers and floats", a: float64(9.5), b: int64(10), expected: true},
+		{name: "strings", a: "net-10", b: "net-9", expected: true},
+		{name: "numbers before strings", a: int64(10), b: "1", expected: true},
+		{name: "strings after numbers", a: "1", b: int64(10), expected: false},
+		{name: "booleans as strings", a: false, b: true, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, lessSortKey(tt.a, tt.b))
+		})
+	}
+}
+
+func TestCustomColumnsTable(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		columns []string
+		rows    [][]interface{}
+		err     string
+	}{
+		{
+			name:    "relaxed and braced expressions",
+			spec:    "NAME:.metadata.name,AGENT:{.status.agent},NATS:status.nats",
+			columns: []string{"NAME", "AGENT", "NATS"},
+			rows: [][]interface{}{
+				{"a", "net-1", "2"},
+				{"b", "<none>", "0"},
+			},
+		},
+		{
+			name: "empty spec",
+			spec: "",
+			err:  "no custom columns given",
+		},
+		{
+			name: "missing expression",
+			spec: "NAME:.metadata.name,AGENT",
+			err:  "unexpected custom-columns spec: AGENT, expected <header>:<json-path-expr>",
+		},
+		{
+			name: "invalid expression",
+			spec: "NAME:{.metadata.name",
+			err:  "unexpected path string",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table, err := customColumnsTable(tt.spec, testRouterList([]string{"net-1", ""}, []int{2, 0}))
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			var columns []string
+			for _, column := range table.ColumnDefinitions {
+				columns = append(columns, column.Name)
+			}
+			assert.Equal(t, tt.columns, columns)
+
+			var rows [][]interface{}
+			for _, row := range table.Rows {
+				rows = append(rows, row.Cells)
+			}
+			assert.Equal(t, tt.rows, rows)
+		})
+	}
+}
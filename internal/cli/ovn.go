@@ -11,6 +11,7 @@ import (
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
 )
 
@@ -127,18 +128,30 @@ func runOVNCommand(configFlags *genericclioptions.ConfigFlags, dbType string, ar
 	}
 	command = append(command, args...)
 
+	// Execute the command
+	return execInPod(context.Background(), restConfig, clientset, opts.namespace, podName, command, remotecommand.StreamOptions{
+		Stdin:  os.Stdin,
+		Stdout: os.Stdout,
+		Stderr: os.Stderr,
+		Tty:    false,
+	})
+}
+
+// execInPod executes a command in a pod via Kubernetes API, streaming its
+// input and output with the given streams
+func execInPod(ctx context.Context, restConfig *rest.Config, clientset kubernetes.Interface, namespace, podName string, command []string, streams remotecommand.StreamOptions) error {
 	// Create exec request
 	req := clientset.CoreV1().RESTClient().Post().
 		Resource("pods").
 		Name(podName).
-		Namespace(opts.namespace).
+		Namespace(namespace).
 		SubResource("exec").
 		VersionedParams(&corev1.PodExecOptions{
 			Command: command,
-			Stdin:   true,
-			Stdout:  true,
-			Stderr:  true,
-			TTY:     false,
+			Stdin:   streams.Stdin != nil,
+			Stdout:  streams.Stdout != nil,
+			Stderr:  streams.Stderr != nil,
+			TTY:     streams.Tty,
 		}, scheme.ParameterCodec)
 
 	// Execute the command
@@ -148,14 +161,7 @@ func runOVNCommand(configFlags *genericclioptions.ConfigFlags, dbType string, ar
 	}
 
 	// Stream the command
-	err = executor.StreamWithContext(context.Background(), remotecommand.StreamOptions{
-		Stdin:  os.Stdin,
-		Stdout: os.Stdout,
-		Stderr: os.Stderr,
-		Tty:    false,
-	})
-
-	if err != nil {
+	if err := executor.StreamWithContext(ctx, streams); err != nil {
 		return fmt.Errorf("failed to execute command: %w", err)
 	}
 
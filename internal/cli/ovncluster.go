@@ -0,0 +1,692 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	"github.com/vexxhost/atmosphere/internal/ovncluster"
+)
+
+// ovnClusterDatabase is a clustered OVN database
+type ovnClusterDatabase struct {
+	// Type is the short name of the database used by the flags
+	Type string
+
+	// Name is the name of the database in the schema
+	Name string
+
+	// ControlSocket is the path of the control socket of the ovsdb-server
+	// serving the database
+	ControlSocket string
+}
+
+// ovnClusterDatabases are the clustered OVN databases
+var ovnClusterDatabases = []ovnClusterDatabase{
+	{Type: "nb", Name: "OVN_Northbound", ControlSocket: "/var/run/ovn/ovnnb_db.ctl"},
+	{Type: "sb", Name: "OVN_Southbound", ControlSocket: "/var/run/ovn/ovnsb_db.ctl"},
+}
+
+// ovnClusterStatus is the status of the cluster of a database
+type ovnClusterStatus struct {
+	// Database is the name of the database
+	Database string `json:"database"`
+
+	// StatefulSet is the name of the StatefulSet running the database
+	StatefulSet string `json:"statefulSet"`
+
+	// Members are the members running in the pods of the StatefulSet
+	Members []ovncluster.Member `json:"members"`
+
+	// Problems are the problems found in the cluster
+	Problems []ovncluster.Problem `json:"problems"`
+}
+
+// ovnClusterClient executes commands in the pods of the clustered OVN
+// databases
+type ovnClusterClient struct {
+	restConfig *rest.Config
+	clientset  kubernetes.Interface
+	opts       *ovnCmdOptions
+}
+
+// newOVNClusterClient creates a new ovnClusterClient
+func newOVNClusterClient(configFlags *genericclioptions.ConfigFlags, opts *ovnCmdOptions) (*ovnClusterClient, error) {
+	restConfig, err := configFlags.ToRESTConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get REST config: %w", err)
+	}
+
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create clientset: %w", err)
+	}
+
+	return &ovnClusterClient{
+		restConfig: restConfig,
+		clientset:  clientset,
+		opts:       opts,
+	}, nil
+}
+
+// statefulSet returns the name of the StatefulSet running the database
+func (c *ovnClusterClient) statefulSet(db ovnClusterDatabase) string {
+	if db.Type == "sb" {
+		return c.opts.sbStatefulSet
+	}
+
+	return c.opts.nbStatefulSet
+}
+
+// pods returns the names of the pods of the StatefulSet running the database
+func (c *ovnClusterClient) pods(ctx context.Context, db ovnClusterDatabase) ([]string, error) {
+	stsName := c.statefulSet(db)
+
+	sts, err := c.clientset.AppsV1().StatefulSets(c.opts.namespace).Get(ctx, stsName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get StatefulSet %q: %w", stsName, err)
+	}
+
+	replicas := int(ptr.Deref(sts.Spec.Replicas, 1))
+	pods := make([]string, 0, replicas)
+	for i := 0; i < replicas; i++ {
+		pods = append(pods, fmt.Sprintf("%s-%d", stsName, i))
+	}
+
+	return pods, nil
+}
+
+// appctl runs `ovn-appctl` against the ovsdb-server of the database in a pod
+// and returns its output
+func (c *ovnClusterClient) appctl(ctx context.Context, podName string, db ovnClusterDatabase, args ...string) (string, error) {
+	command := append([]string{"ovn-appctl", "-t", db.ControlSocket}, args...)
+
+	var stdout, stderr bytes.Buffer
+	if err := execInPod(ctx, c.restConfig, c.clientset, c.opts.namespace, podName, command, remotecommand.StreamOptions{
+		Stdout: &stdout,
+		Stderr: &stderr,
+	}); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return "", fmt.Errorf("%w: %s", err, message)
+		}
+		return "", err
+	}
+
+	return stdout.String(), nil
+}
+
+// status returns the status of every member of the cluster of the database,
+// the members which could not report their status are returned with the
+// reason
+func (c *ovnClusterClient) status(ctx context.Context, db ovnClusterDatabase) ([]ovncluster.Member, error) {
+	pods, err := c.pods(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]ovncluster.Member, 0, len(pods))
+	for _, podName := range pods {
+		member := ovncluster.Member{Pod: podName}
+
+		output, err := c.appctl(ctx, podName, db, "cluster/status", db.Name)
+		if err == nil {
+			member.Status, err = ovncluster.ParseStatus(output)
+		}
+		if err != nil {
+			member.Error = err.Error()
+		}
+
+		members = append(members, member)
+	}
+
+	return members, nil
+}
+
+// NewOVNCommand creates the ovn command grouping the operations on the
+// clustered OVN databases
+func NewOVNCommand(configFlags *genericclioptions.ConfigFlags) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ovn",
+		Short: "Manage the clustered OVN databases",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := cmd.Help(); err != nil {
+				fmt.Fprintf(os.Stderr, "Error showing help: %v\n", err)
+			}
+		},
+	}
+
+	cmd.AddCommand(newOVNStatusCommand(configFlags))
+	cmd.AddCommand(newOVNTransferLeadershipCommand(configFlags))
+	cmd.AddCommand(newOVNKickMemberCommand(configFlags))
+
+	return cmd
+}
+
+// OVNStatusCmd handles the ovn status command
+type OVNStatusCmd struct {
+	configFlags *genericclioptions.ConfigFlags
+	opts        *ovnCmdOptions
+
+	// Command options
+	outputFormat string
+	databases    []string
+	maxLag       uint64
+	timeout      time.Duration
+}
+
+// newOVNStatusCommand creates the ovn status command
+func newOVNStatusCommand(configFlags *genericclioptions.ConfigFlags) *cobra.Command {
+	s := &OVNStatusCmd{
+		configFlags: configFlags,
+		opts:        defaultOVNOptions(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the RAFT cluster health of the OVN databases",
+		Long: `Show the RAFT cluster health of the OVN databases.
+
+This command runs "ovn-appctl cluster/status" in every pod of the northbound
+and southbound database StatefulSets and reports the cluster ID, server ID,
+role, term, log index, election timer and connections of every member.
+
+The following problems are reported, in which case the command fails:
+
+  SplitBrain       Members report different clusters, more than one leader,
+                   or follow another leader
+  NoLeader         No member is the leader
+  MissingMember    A pod did not report its status or is not a member of the
+                   cluster, or a server of the cluster is not running in any
+                   pod
+  LaggingFollower  The log of a follower is more than --max-lag entries behind
+                   the log of the leader
+
+Examples:
+  # Show the health of the northbound and southbound clusters
+  atmosphere ovn status
+
+  # Only show the health of the southbound cluster
+  atmosphere ovn status --database sb
+
+  # Output the status in JSON format
+  atmosphere ovn status -o json`,
+		RunE: s.run,
+	}
+
+	// Add flags
+	cmd.Flags().StringVarP(&s.outputFormat, "output", "o", "", "Output format. One of: (json, yaml)")
+	cmd.Flags().StringSliceVar(&s.databases, "database", []string{"nb", "sb"}, "Databases to show the status of. One or more of: (nb, sb)")
+	cmd.Flags().Uint64Var(&s.maxLag, "max-lag", 100, "Number of log entries a follower can be behind the leader before it is reported as lagging")
+	cmd.Flags().DurationVar(&s.timeout, "timeout", 30*time.Second, "Timeout for retrieving the status of all members")
+
+	// OVN configuration flags
+	cmd.Flags().StringVar(&s.opts.namespace, "ovn-namespace", s.opts.namespace, "Namespace where OVN is deployed")
+
+	return cmd
+}
+
+// run executes the ovn status command
+func (s *OVNStatusCmd) run(cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %v", args)
+	}
+
+	if s.outputFormat != "" && s.outputFormat != "json" && s.outputFormat != "yaml" {
+		return fmt.Errorf("unsupported output format: %s", s.outputFormat)
+	}
+
+	databases, err := selectOVNClusterDatabases(s.databases)
+	if err != nil {
+		return err
+	}
+
+	clusterClient, err := newOVNClusterClient(s.configFlags, s.opts)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	clusters := make([]ovnClusterStatus, 0, len(databases))
+	problemCount := 0
+	for _, db := range databases {
+		members, err := clusterClient.status(ctx, db)
+		if err != nil {
+			return err
+		}
+
+		cluster := ovnClusterStatus{
+			Database:    db.Name,
+			StatefulSet: clusterClient.statefulSet(db),
+			Members:     members,
+			Problems:    ovncluster.Check(members, s.maxLag),
+		}
+		problemCount += len(cluster.Problems)
+
+		clusters = append(clusters, cluster)
+	}
+
+	if err := s.printClusters(clusters, os.Stdout); err != nil {
+		return err
+	}
+
+	if problemCount > 0 {
+		return fmt.Errorf("found %d problem(s) in the OVN clusters", problemCount)
+	}
+
+	return nil
+}
+
+// selectOVNClusterDatabases returns the databases with the given types
+func selectOVNClusterDatabases(types []string) ([]ovnClusterDatabase, error) {
+	var databases []ovnClusterDatabase
+	for _, dbType := range types {
+		db, err := selectOVNClusterDatabase(dbType)
+		if err != nil {
+			return nil, err
+		}
+
+		databases = append(databases, db)
+	}
+
+	return databases, nil
+}
+
+// selectOVNClusterDatabase returns the database with the given type
+func selectOVNClusterDatabase(dbType string) (ovnClusterDatabase, error) {
+	for _, db := range ovnClusterDatabases {
+		if db.Type == dbType {
+			return db, nil
+		}
+	}
+
+	return ovnClusterDatabase{}, fmt.Errorf("invalid database type: %s", dbType)
+}
+
+// printClusters prints the status of the clusters as tables or in the
+// requested output format
+func (s *OVNStatusCmd) printClusters(clusters []ovnClusterStatus, out io.Writer) error {
+	switch s.outputFormat {
+	case "json":
+		data, err := json.MarshalIndent(clusters, "", "    ")
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(out, "%s\n", data)
+		return err
+	case "yaml":
+		data, err := yaml.Marshal(clusters)
+		if err != nil {
+			return err
+		}
+
+		_, err = out.Write(data)
+		return err
+	}
+
+	members := &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Table",
+			APIVersion: "meta.k8s.io/v1",
+		},
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "DATABASE", Type: "string", Description: "Name of the database"},
+			{Name: "POD", Type: "string", Description: "Pod running the member"},
+			{Name: "CLUSTER-ID", Type: "string", Description: "Cluster ID"},
+			{Name: "SERVER-ID", Type: "string", Description: "Server ID of the member"},
+			{Name: "ROLE", Type: "string", Description: "RAFT role of the member"},
+			{Name: "TERM", Type: "string", Description: "Current RAFT term"},
+			{Name: "LEADER", Type: "string", Description: "Server ID of the leader"},
+			{Name: "LOG-INDEX", Type: "string", Description: "Index of the next log entry"},
+			{Name: "ELECTION-TIMER", Type: "string", Description: "Election timer in milliseconds"},
+			{Name: "CONNECTIONS", Type: "string", Description: "Connections to the other servers"},
+			{Name: "STATUS", Type: "string", Description: "Membership status of the member"},
+		},
+	}
+
+	problems := &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Table",
+			APIVersion: "meta.k8s.io/v1",
+		},
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "DATABASE", Type: "string", Description: "Name of the database"},
+			{Name: "PROBLEM", Type: "string", Description: "Type of the problem"},
+			{Name: "MESSAGE", Type: "string", Description: "Description of the problem"},
+		},
+	}
+
+	for _, cluster := range clusters {
+		for _, member := range cluster.Members {
+			status := member.Status
+			if status == nil {
+				members.Rows = append(members.Rows, metav1.TableRow{
+					Cells: []interface{}{cluster.Database, member.Pod, "<none>", "<none>", "<none>", "<none>", "<none>", "<none>", "<none>", "<none>", member.Error},
+				})
+				continue
+			}
+
+			connections := "<none>"
+			if len(status.Connections) > 0 {
+				connections = strings.Join(status.Connections, ",")
+			}
+
+			members.Rows = append(members.Rows, metav1.TableRow{
+				Cells: []interface{}{
+					cluster.Database,
+					member.Pod,
+					ovncluster.ShortID(status.ClusterID),
+					ovncluster.ShortID(status.ServerID),
+					string(status.Role),
+					strconv.FormatUint(status.Term, 10),
+					status.Leader,
+					strconv.FormatUint(status.LogIndex, 10),
+					strconv.FormatUint(status.ElectionTimer, 10),
+					connections,
+					status.Status,
+				},
+			})
+		}
+
+		for _, problem := range cluster.Problems {
+			problems.Rows = append(problems.Rows, metav1.TableRow{
+				Cells: []interface{}{cluster.Database, string(problem.Type), problem.Message},
+			})
+		}
+	}
+
+	printer := printers.NewTablePrinter(printers.PrintOptions{})
+	if err := printer.PrintObj(members, out); err != nil {
+		return err
+	}
+
+	if len(problems.Rows) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(out)
+	return printer.PrintObj(problems, out)
+}
+
+// waitForLeader polls the status of the cluster until a member other than the
+// given server is the leader, and returns it
+func (c *ovnClusterClient) waitForLeader(ctx context.Context, db ovnClusterDatabase, previousServerID string) (*ovncluster.Member, error) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("failed waiting for a new leader of %s: %w", db.Name, ctx.Err())
+		case <-ticker.C:
+		}
+
+		members, err := c.status(ctx, db)
+		if err != nil {
+			continue
+		}
+
+		// NOTE: The server which left no longer reports being a member, so it
+		//       is ignored while the remaining members elect a new leader.
+		var remaining []ovncluster.Member
+		for _, member := range members {
+			if member.Status != nil && ovncluster.ShortID(member.Status.ServerID) != previousServerID {
+				remaining = append(remaining, member)
+			}
+		}
+
+		leader, err := ovncluster.Leader(remaining)
+		if err != nil {
+			continue
+		}
+
+		return leader, nil
+	}
+}
+
+// OVNTransferLeadershipCmd handles the ovn transfer-leadership command
+type OVNTransferLeadershipCmd struct {
+	configFlags *genericclioptions.ConfigFlags
+	opts        *ovnCmdOptions
+
+	// Command options
+	database string
+	to       string
+	timeout  time.Duration
+}
+
+// newOVNTransferLeadershipCommand creates the ovn transfer-leadership command
+func newOVNTransferLeadershipCommand(configFlags *genericclioptions.ConfigFlags) *cobra.Command {
+	t := &OVNTransferLeadershipCmd{
+		configFlags: configFlags,
+		opts:        defaultOVNOptions(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "transfer-leadership --db nb|sb [--to <pod>]",
+		Short: "Move the RAFT leadership of an OVN database by evicting its leader",
+		Long: `Move the RAFT leadership of an OVN database by evicting its leader.
+
+This command runs "ovn-appctl cluster/leave" on the pod of the current leader,
+which hands the leadership over to the most up to date follower before leaving
+the cluster, and waits until a new leader is elected.
+
+WARNING: This permanently shrinks the RAFT cluster by one member, the server of
+the previous leader is evicted from the cluster. Restarting its pod is not
+enough for it to join again, its database file must be removed so that it is
+created again with "ovsdb-tool join-cluster" when the pod restarts. This is not
+a routine step to move the leadership before a maintenance, it is meant for a
+leader which has to be removed or rebuilt anyway.
+
+The command refuses to run when the members do not agree on a single leader,
+or when the cluster left with one server less would not have a majority of
+healthy servers.
+
+When --to is given, the pod must run the only follower with the most up to
+date log, since it is the follower the leadership is handed over to, and the
+command fails if the leadership was handed over to another follower anyway.
+
+Examples:
+  # Move the leadership of the northbound database away from its leader
+  atmosphere ovn transfer-leadership --db nb
+
+  # Move the leadership of the southbound database to a specific pod
+  atmosphere ovn transfer-leadership --db sb --to ovn-ovsdb-sb-2`,
+		RunE: t.run,
+	}
+
+	// Add flags
+	cmd.Flags().StringVar(&t.database, "db", "", "Database to transfer the leadership of. One of: (nb, sb)")
+	cmd.Flags().StringVar(&t.to, "to", "", "Pod expected to become the leader, it must run the most up to date follower")
+	cmd.Flags().DurationVar(&t.timeout, "timeout", time.Minute, "Timeout for the leadership transfer")
+	_ = cmd.MarkFlagRequired("db")
+
+	// OVN configuration flags
+	cmd.Flags().StringVar(&t.opts.namespace, "ovn-namespace", t.opts.namespace, "Namespace where OVN is deployed")
+
+	return cmd
+}
+
+// run executes the ovn transfer-leadership command
+func (t *OVNTransferLeadershipCmd) run(cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %v", args)
+	}
+
+	db, err := selectOVNClusterDatabase(t.database)
+	if err != nil {
+		return err
+	}
+
+	clusterClient, err := newOVNClusterClient(t.configFlags, t.opts)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), t.timeout)
+	defer cancel()
+
+	members, err := clusterClient.status(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	leader, err := ovncluster.Leader(members)
+	if err != nil {
+		return err
+	}
+	leaderID := ovncluster.ShortID(leader.Status.ServerID)
+
+	// NOTE: ovsdb-server has no command to hand the leadership over to a given
+	//       server, cluster/leave hands it over to the most up to date
+	//       follower, so the pod can only be checked to run it.
+	if t.to != "" {
+		if err := ovncluster.CheckLeadershipTarget(members, t.to); err != nil {
+			return fmt.Errorf("refusing to transfer the leadership of %s to pod %s: %w", db.Name, t.to, err)
+		}
+	}
+
+	if err := ovncluster.CheckRemoval(members, leaderID); err != nil {
+		return fmt.Errorf("refusing to transfer the leadership of %s: %w", db.Name, err)
+	}
+
+	fmt.Printf("Transferring the leadership of %s away from pod %s (server %s)...\n", db.Name, leader.Pod, leaderID)
+	if _, err := clusterClient.appctl(ctx, leader.Pod, db, "cluster/leave", db.Name); err != nil {
+		return fmt.Errorf("failed to leave the cluster of %s on pod %s: %w", db.Name, leader.Pod, err)
+	}
+
+	newLeader, err := clusterClient.waitForLeader(ctx, db, leaderID)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Pod %s (server %s) is the new leader of %s\n", newLeader.Pod, ovncluster.ShortID(newLeader.Status.ServerID), db.Name)
+	fmt.Printf("Pod %s left the cluster of %s, remove its database file and restart it to join the cluster again\n", leader.Pod, db.Name)
+
+	if t.to != "" && newLeader.Pod != t.to {
+		return fmt.Errorf("the leadership of %s was transferred to pod %s instead of %s", db.Name, newLeader.Pod, t.to)
+	}
+
+	return nil
+}
+
+// OVNKickMemberCmd handles the ovn kick-member command
+type OVNKickMemberCmd struct {
+	configFlags *genericclioptions.ConfigFlags
+	opts        *ovnCmdOptions
+
+	// Command options
+	database string
+	timeout  time.Duration
+}
+
+// newOVNKickMemberCommand creates the ovn kick-member command
+func newOVNKickMemberCommand(configFlags *genericclioptions.ConfigFlags) *cobra.Command {
+	k := &OVNKickMemberCmd{
+		configFlags: configFlags,
+		opts:        defaultOVNOptions(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "kick-member --db nb|sb <pod|server-id>",
+		Short: "Remove a member from the RAFT cluster of an OVN database",
+		Long: `Remove a member from the RAFT cluster of an OVN database.
+
+This command runs "ovn-appctl cluster/kick" on the pod of the leader to remove
+the server running in the given pod, or with the given server ID, from the
+cluster. This is typically used to remove a server which no longer runs in any
+pod, as reported by "atmosphere ovn status".
+
+The command refuses to kick the leader, which should be moved away with
+"atmosphere ovn transfer-leadership" first, and to run when the members do not
+agree on a single leader or when the cluster would be left without a majority
+of healthy servers once the member is removed.
+
+Examples:
+  # Remove a server which is no longer running in any pod
+  atmosphere ovn kick-member --db nb c4d2
+
+  # Remove the member running in a pod
+  atmosphere ovn kick-member --db sb ovn-ovsdb-sb-2`,
+		Args: cobra.ExactArgs(1),
+		RunE: k.run,
+	}
+
+	// Add flags
+	cmd.Flags().StringVar(&k.database, "db", "", "Database to remove the member from. One of: (nb, sb)")
+	cmd.Flags().DurationVar(&k.timeout, "timeout", 30*time.Second, "Timeout for removing the member")
+	_ = cmd.MarkFlagRequired("db")
+
+	// OVN configuration flags
+	cmd.Flags().StringVar(&k.opts.namespace, "ovn-namespace", k.opts.namespace, "Namespace where OVN is deployed")
+
+	return cmd
+}
+
+// run executes the ovn kick-member command
+func (k *OVNKickMemberCmd) run(cmd *cobra.Command, args []string) error {
+	db, err := selectOVNClusterDatabase(k.database)
+	if err != nil {
+		return err
+	}
+
+	clusterClient, err := newOVNClusterClient(k.configFlags, k.opts)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), k.timeout)
+	defer cancel()
+
+	members, err := clusterClient.status(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	leader, err := ovncluster.Leader(members)
+	if err != nil {
+		return err
+	}
+
+	serverID, err := ovncluster.FindServer(members, args[0])
+	if err != nil {
+		return err
+	}
+
+	if serverID == ovncluster.ShortID(leader.Status.ServerID) {
+		return fmt.Errorf("server %s is the leader of %s, transfer the leadership first", serverID, db.Name)
+	}
+
+	if err := ovncluster.CheckRemoval(members, serverID); err != nil {
+		return fmt.Errorf("refusing to kick server %s from %s: %w", serverID, db.Name, err)
+	}
+
+	output, err := clusterClient.appctl(ctx, leader.Pod, db, "cluster/kick", db.Name, serverID)
+	if err != nil {
+		return fmt.Errorf("failed to kick server %s from %s: %w", serverID, db.Name, err)
+	}
+
+	if output = strings.TrimSpace(output); output != "" {
+		fmt.Println(output)
+	}
+	fmt.Printf("Kicked server %s from %s\n", serverID, db.Name)
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"sync"
+)
+
+// forEachOrdered calls fn for every index in [0, n) using at most parallelism
+// concurrent workers, and calls done for every index in order as soon as its
+// result and the results of all previous indexes are available, so that output
+// is never interleaved.
+func forEachOrdered(n, parallelism int, fn func(i int) error, done func(i int, err error)) {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	results := make([]chan error, n)
+	for i := range results {
+		results[i] = make(chan error, 1)
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, parallelism)
+
+	go func() {
+		for i := 0; i < n; i++ {
+			sem <- struct{}{}
+			wg.Add(1)
+
+			go func(i int) {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				results[i] <- fn(i)
+			}(i)
+		}
+	}()
+
+	for i := 0; i < n; i++ {
+		done(i, <-results[i])
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,133 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForEachOrdered(t *testing.T) {
+	tests := []struct {
+		name        string
+		n           int
+		parallelism int
+		expectedMax int32
+	}{
+		{
+			name:        "no indexes",
+			n:           0,
+			parallelism: 2,
+		},
+		{
+			name:        "sequential",
+			n:           5,
+			parallelism: 1,
+			expectedMax: 1,
+		},
+		{
+			name:        "zero parallelism",
+			n:           5,
+			parallelism: 0,
+			expectedMax: 1,
+		},
+		{
+			name:        "negative parallelism",
+			n:           5,
+			parallelism: -1,
+			expectedMax: 1,
+		},
+		{
+			name:        "limited parallelism",
+			n:           10,
+			parallelism: 3,
+			expectedMax: 3,
+		},
+		{
+			name:        "parallelism above the number of indexes",
+			n:           3,
+			parallelism: 10,
+			expectedMax: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var running, maxRunning int32
+			var order []int
+
+			forEachOrdered(tt.n, tt.parallelism, func(i int) error {
+				current := atomic.AddInt32(&running, 1)
+				defer atomic.AddInt32(&running, -1)
+
+				for {
+					previous := atomic.LoadInt32(&maxRunning)
+					if current <= previous || atomic.CompareAndSwapInt32(&maxRunning, previous, current) {
+						break
+					}
+				}
+
+				time.Sleep(10 * time.Millisecond)
+				return fmt.Errorf("index %d", i)
+			}, func(i int, err error) {
+				assert.EqualError(t, err, fmt.Sprintf("index %d", i))
+				order = append(order, i)
+			})
+
+			assert.Len(t, order, tt.n)
+			for i, index := range order {
+				assert.Equal(t, i, index)
+			}
+			assert.LessOrEqual(t, maxRunning, tt.expectedMax)
+			if tt.n > 0 {
+				assert.Positive(t, maxRunning)
+			}
+		})
+	}
+}
+
+func TestForEachOrdered_OutOfOrderCompletion(t *testing.T) {
+	const n = 4
+
+	// Every index waits for the next one to finish, so that the last index
+	// finishes first and the first index finishes last
+	finished := make([]chan struct{}, n)
+	for i := range finished {
+		finished[i] = make(chan struct{})
+	}
+
+	var mu sync.Mutex
+	var completion, order []int
+
+	forEachOrdered(n, n, func(i int) error {
+		if i < n-1 {
+			<-finished[i+1]
+		}
+
+		mu.Lock()
+		completion = append(completion, i)
+		mu.Unlock()
+
+		close(finished[i])
+
+		if i == 1 {
+			return errors.New("failed")
+		}
+		return nil
+	}, func(i int, err error) {
+		if i == 1 {
+			assert.EqualError(t, err, "failed")
+		} else {
+			assert.NoError(t, err)
+		}
+
+		order = append(order, i)
+	})
+
+	assert.Equal(t, []int{3, 2, 1, 0}, completion)
+	assert.Equal(t, []int{0, 1, 2, 3}, order)
+}
@@ -0,0 +1,314 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"time"
+
+	"github.com/ovn-org/libovsdb/client"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/printers"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+	"github.com/vexxhost/atmosphere/internal/cli/resources"
+	"github.com/vexxhost/atmosphere/internal/ovnhealth"
+	"github.com/vexxhost/atmosphere/internal/ovnrouter"
+)
+
+// RebalanceCmd handles the rebalance command
+type RebalanceCmd struct {
+	configFlags *genericclioptions.ConfigFlags
+	ovnConfig   *resources.OVNConfig
+
+	// Command options
+	ovnEndpoints    []string
+	ovnSBEndpoints  []string
+	ovnNamespace    string
+	skipHealthCheck bool
+	timeout         time.Duration
+	dryRun          bool
+	maxMoves        int
+	parallelism     int
+	retries         int
+	journal         string
+	weights         map[string]int
+}
+
+// NewRebalanceCommand creates a new rebalance command
+func NewRebalanceCommand(configFlags *genericclioptions.ConfigFlags) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebalance",
+		Short: "Rebalance resources across the cluster",
+		Long:  `Rebalance resources across the cluster.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := cmd.Help(); err != nil {
+				fmt.Fprintf(os.Stderr, "Error showing help: %v\n", err)
+			}
+		},
+	}
+
+	cmd.AddCommand(newRebalanceRoutersCommand(configFlags))
+
+	return cmd
+}
+
+// newRebalanceRoutersCommand creates the rebalance routers subcommand
+func newRebalanceRoutersCommand(configFlags *genericclioptions.ConfigFlags) *cobra.Command {
+	r := &RebalanceCmd{
+		configFlags: configFlags,
+		ovnConfig:   resources.DefaultOVNConfig(),
+		timeout:     30 * time.Second,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "routers",
+		Aliases: []string{"router"},
+		Short:   "Even out the number of active routers per gateway chassis",
+		Long: `Even out the number of active routers per gateway chassis.
+
+This command reads the current hosting chassis of every router, computes a plan
+which evens out the number of active routers per gateway chassis and moves the
+routers by swapping the priorities of their gateway chassis.
+
+Routers are only moved to chassis which are members of their gateway set and
+which are alive and gateway capable according to the southbound database. Each
+chassis has a weight of 1 by default, a chassis with a weight of 2 will host twice
+as many routers and a chassis with a weight of 0 will not host any.
+
+Before moving any router, a failover journal recording the original priorities
+of every changed row is written to the path given by --journal. The rebalance
+can be undone later by passing that journal to "atmosphere failover --revert".
+
+Examples:
+  # Show the rebalance plan without moving any routers
+  atmosphere rebalance routers --dry-run
+
+  # Rebalance all routers
+  atmosphere rebalance routers
+
+  # Move at most 50 routers, 10 at a time
+  atmosphere rebalance routers --max-moves 50 --parallelism 10
+
+  # Give a chassis twice as many routers as the others
+  atmosphere rebalance routers --weight network-node-3=2
+
+  # Undo a rebalance using its journal
+  atmosphere failover --revert failover-journal-20250101T000000Z.json`,
+		RunE: r.run,
+	}
+
+	// Add flags
+	cmd.Flags().BoolVar(&r.dryRun, "dry-run", false, "Only print the rebalance plan without moving any routers")
+	cmd.Flags().IntVar(&r.maxMoves, "max-moves", 0, "Maximum number of routers to move (0 means no limit)")
+	cmd.Flags().IntVar(&r.parallelism, "parallelism", 4, "Number of routers to move concurrently")
+	cmd.Flags().IntVar(&r.retries, "retries", 3, "Number of times to recompute and retry a move when priorities were changed concurrently")
+	cmd.Flags().StringVar(&r.journal, "journal", "", "Path of the failover journal to write (default: failover-journal-<timestamp>.json)")
+	cmd.Flags().StringToIntVar(&r.weights, "weight", nil, "Relative weight of a chassis in the form chassis=weight (default weight is 1)")
+	cmd.Flags().DurationVar(&r.timeout, "timeout", 30*time.Second, "Timeout for each router failover")
+	cmd.Flags().BoolVar(&r.skipHealthCheck, "skip-health-check", false, "Do not check that the chassis routers are moved to are alive and gateway capable")
+
+	// OVN configuration flags
+	cmd.Flags().StringSliceVar(&r.ovnEndpoints, "ovn-endpoints", nil, "OVN database endpoints (default: auto-generated from namespace and statefulset)")
+	cmd.Flags().StringSliceVar(&r.ovnSBEndpoints, "ovn-sb-endpoints", nil, "OVN southbound database endpoints used for chassis health checks and finding the chassis hosting routers (default: auto-generated from namespace and statefulset)")
+	cmd.Flags().StringVar(&r.ovnNamespace, "ovn-namespace", "openstack", "Namespace where OVN is deployed")
+
+	return cmd
+}
+
+// run executes the rebalance routers command
+func (r *RebalanceCmd) run(cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %v", args)
+	}
+
+	if r.maxMoves < 0 {
+		return fmt.Errorf("--max-moves must not be negative")
+	}
+
+	if r.parallelism < 1 {
+		return fmt.Errorf("--parallelism must be at least 1")
+	}
+
+	if r.retries < 0 {
+		return fmt.Errorf("--retries must not be negative")
+	}
+
+	// Update OVN config with command line options
+	if len(r.ovnEndpoints) > 0 {
+		r.ovnConfig.Endpoints = r.ovnEndpoints
+	}
+	if len(r.ovnSBEndpoints) > 0 {
+		r.ovnConfig.SBEndpoints = r.ovnSBEndpoints
+	}
+	if r.ovnNamespace != "" {
+		r.ovnConfig.Namespace = r.ovnNamespace
+	}
+
+	// Connect to OVN
+	ctx := context.Background()
+	ovnClient, err := r.connectToOVN(ctx)
+	if err != nil {
+		return err
+	}
+	defer ovnClient.Close()
+
+	// Only move routers to healthy chassis, the southbound database is also
+	// needed to find the chassis hosting routers when the northbound schema
+	// predates the status of router ports
+	var managerOpts []ovnrouter.ManagerOption
+	if !r.skipHealthCheck || !ovnrouter.HasPortStatus(ovnClient) {
+		sbClient, err := r.connectToOVNSouthbound(ctx)
+		if err != nil {
+			return err
+		}
+		defer sbClient.Close()
+
+		managerOpts = append(managerOpts, ovnrouter.WithSouthbound(sbClient))
+		if !r.skipHealthCheck {
+			managerOpts = append(managerOpts, ovnrouter.WithHealthChecker(ovnhealth.NewChecker(sbClient)))
+		}
+	}
+
+	// Create router manager
+	routerManager := ovnrouter.NewManager(ovnClient, managerOpts...)
+
+	moves, err := routerManager.PlanRebalance(ctx, ovnrouter.RebalanceOptions{
+		Weights:  r.weights,
+		MaxMoves: r.maxMoves,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to plan rebalance: %w", err)
+	}
+
+	if len(moves) == 0 {
+		fmt.Println("Routers are already balanced")
+		return nil
+	}
+
+	if err := r.printPlan(moves); err != nil {
+		return err
+	}
+
+	if r.dryRun {
+		return nil
+	}
+
+	fmt.Println()
+
+	// moveOptions returns the options of the failover moving a router
+	moveOptions := func(move *ovnrouter.RebalanceMove) ovnrouter.FailoverOptions {
+		return ovnrouter.FailoverOptions{
+			TargetChassis: move.To,
+			Strategy:      ovnrouter.FailoverStrategySwap,
+			Retries:       r.retries,
+		}
+	}
+
+	// Compute the priority changes of every move, so that they can be recorded
+	// in the journal before moving any router
+	var plans []apiv1alpha1.FailoverPlan
+	var planned []ovnrouter.RebalanceMove
+	failureCount := 0
+
+	for _, move := range moves {
+		plan, err := routerManager.PlanFailover(ctx, &move.Router, moveOptions(&move))
+		if err != nil {
+			fmt.Printf("Moving router %s from %s to %s... FAILED: %v\n", routerDisplayName(&move.Router), move.From, move.To, err)
+			failureCount++
+			continue
+		}
+
+		plans = append(plans, *plan)
+		planned = append(planned, move)
+	}
+
+	journalPath, journal, err := writeJournal(r.journal, plans)
+	if err != nil {
+		return err
+	}
+
+	// Move the routers
+	successCount := 0
+	applied := make([]*apiv1alpha1.FailoverPlan, len(plans))
+
+	forEachOrdered(len(planned), r.parallelism, func(i int) error {
+		// Create a context with timeout for this specific failover
+		failoverCtx, cancel := context.WithTimeout(ctx, r.timeout)
+		defer cancel()
+
+		var err error
+		applied[i], err = routerManager.ApplyFailoverPlan(failoverCtx, &planned[i].Router, &plans[i], moveOptions(&planned[i]))
+		return err
+	}, func(i int, err error) {
+		move := planned[i]
+
+		if err != nil {
+			fmt.Printf("Moving router %s from %s to %s... FAILED: %v\n", routerDisplayName(&move.Router), move.From, move.To, err)
+			failureCount++
+		} else {
+			fmt.Printf("Moving router %s from %s to %s... SUCCESS\n", routerDisplayName(&move.Router), move.From, move.To)
+			successCount++
+		}
+	})
+
+	// Print summary
+	fmt.Printf("\nRebalance complete: %d succeeded, %d failed\n", successCount, failureCount)
+
+	if err := updateJournal(journalPath, journal, plans, applied); err != nil {
+		return err
+	}
+
+	if failureCount > 0 {
+		return fmt.Errorf("%d router(s) failed to move", failureCount)
+	}
+
+	return nil
+}
+
+// printPlan prints the rebalance plan as a table
+func (r *RebalanceCmd) printPlan(moves []ovnrouter.RebalanceMove) error {
+	table := &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Table",
+			APIVersion: "meta.k8s.io/v1",
+		},
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "UUID", Type: "string", Description: "Router UUID"},
+			{Name: "NAME", Type: "string", Description: "Router name from Neutron"},
+			{Name: "FROM", Type: "string", Description: "Current hosting chassis"},
+			{Name: "TO", Type: "string", Description: "Chassis the router will be moved to"},
+		},
+	}
+
+	for _, move := range moves {
+		table.Rows = append(table.Rows, metav1.TableRow{
+			Cells: []interface{}{
+				string(move.Router.UID),
+				move.Router.Name,
+				move.From,
+				move.To,
+			},
+		})
+	}
+
+	printer := printers.NewTablePrinter(printers.PrintOptions{})
+	return printer.PrintObj(table, os.Stdout)
+}
+
+// connectToOVNSouthbound establishes connection to the OVN southbound database
+// used to check the health of chassis and find the chassis hosting routers
+func (r *RebalanceCmd) connectToOVNSouthbound(ctx context.Context) (client.Client, error) {
+	tables := ovnhealth.Tables()
+	maps.Copy(tables, ovnrouter.SouthboundTables())
+
+	return connectToOVNDatabase(ctx, "OVN_Southbound", r.ovnConfig.GetSBEndpoints(), tables)
+}
+
+// connectToOVN establishes connection to OVN database
+func (r *RebalanceCmd) connectToOVN(ctx context.Context) (client.Client, error) {
+	return connectToOVNDatabase(ctx, "OVN_Northbound", r.ovnConfig.GetNBEndpoints(), ovnrouter.Tables(), client.WithLeaderOnly(true))
+}
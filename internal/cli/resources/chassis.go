@@ -0,0 +1,219 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ovn-org/libovsdb/client"
+	"github.com/ovn-org/libovsdb/model"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/sbdb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+	"github.com/vexxhost/atmosphere/internal/ovnhealth"
+	"github.com/vexxhost/atmosphere/internal/ovnrouter"
+)
+
+// ChassisResource handles chassis resources
+type ChassisResource struct{}
+
+// Name returns the resource name
+func (c *ChassisResource) Name() string {
+	return "chassis"
+}
+
+// Aliases returns alternative names for the resource
+func (c *ChassisResource) Aliases() []string {
+	return nil
+}
+
+// NorthboundTables returns the northbound tables needed to count the routers
+// hosted on every chassis
+func (c *ChassisResource) NorthboundTables() map[string]model.Model {
+	return (&RouterResource{}).NorthboundTables()
+}
+
+// SouthboundTables returns the southbound tables needed to list chassis and
+// find the routers they host
+func (c *ChassisResource) SouthboundTables(nb client.Client) map[string]model.Model {
+	tables := ovnhealth.Tables()
+	tables[sbdb.EncapTable] = &sbdb.Encap{}
+	maps.Copy(tables, (&RouterResource{}).SouthboundTables(nb))
+
+	return tables
+}
+
+// List fetches chassis and returns them as a runtime.Object
+func (c *ChassisResource) List(ctx context.Context, clients *Clients, names []string) (runtime.Object, error) {
+	var rows []sbdb.Chassis
+	if err := clients.SB.List(ctx, &rows); err != nil {
+		return nil, fmt.Errorf("failed to list chassis: %w", err)
+	}
+
+	// Count the routers hosted on every chassis
+	routerManager := ovnrouter.NewManager(clients.NB, ovnrouter.WithSouthbound(clients.SB))
+	routerList, err := routerManager.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list routers: %w", err)
+	}
+
+	activeRouters := make(map[string]int)
+	for _, router := range routerList.Items {
+		if router.Status.Agent != "" {
+			activeRouters[router.Status.Agent]++
+		}
+	}
+
+	// Filter by name or hostname if specified
+	nameSet := make(map[string]bool)
+	for _, name := range names {
+		nameSet[name] = true
+	}
+
+	checker := ovnhealth.NewChecker(clients.SB)
+	chassisList := &apiv1alpha1.ChassisList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ChassisList",
+			APIVersion: "atmosphere.vexxhost.com/v1alpha1",
+		},
+		Items: []apiv1alpha1.Chassis{},
+	}
+
+	for _, row := range rows {
+		if len(nameSet) > 0 && !nameSet[row.Name] && !nameSet[row.Hostname] {
+			continue
+		}
+
+		health, err := checker.Status(ctx, row.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get health of chassis %q: %w", row.Name, err)
+		}
+
+		chassis := apiv1alpha1.Chassis{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Chassis",
+				APIVersion: "atmosphere.vexxhost.com/v1alpha1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   row.Name,
+				UID:    types.UID(row.UUID),
+				Labels: maps.Clone(row.ExternalIDs),
+			},
+			Status: apiv1alpha1.ChassisStatus{
+				Hostname:       row.Hostname,
+				GatewayCapable: health.GatewayCapable,
+				Alive:          health.Alive,
+				NbCfgLag:       health.NbCfgLag,
+				ActiveRouters:  activeRouters[row.Name],
+			},
+		}
+
+		if health.LastSeen != nil {
+			chassis.Status.LastSeen = &metav1.Time{Time: *health.LastSeen}
+		}
+
+		for _, encapUUID := range row.Encaps {
+			encap := sbdb.Encap{UUID: encapUUID}
+			if err := clients.SB.Get(ctx, &encap); err != nil {
+				return nil, fmt.Errorf("failed to get encap %q for chassis %q: %w", encapUUID, row.Name, err)
+			}
+
+			chassis.Status.EncapIPs = append(chassis.Status.EncapIPs, encap.IP)
+		}
+		sort.Strings(chassis.Status.EncapIPs)
+
+		chassisList.Items = append(chassisList.Items, chassis)
+	}
+
+	// Sort chassis by name for consistent output
+	sort.Slice(chassisList.Items, func(i, j int) bool {
+		return chassisList.Items[i].Name < chassisList.Items[j].Name
+	})
+
+	return chassisList, nil
+}
+
+// GetTable converts a runtime.Object list to a table representation (standard view)
+func (c *ChassisResource) GetTable(obj runtime.Object) (*metav1.Table, error) {
+	return c.getTable(obj, false)
+}
+
+// GetWideTable converts a runtime.Object list to a wide table representation
+func (c *ChassisResource) GetWideTable(obj runtime.Object) (*metav1.Table, error) {
+	return c.getTable(obj, true)
+}
+
+// getTable builds the standard or wide table representation of chassis
+func (c *ChassisResource) getTable(obj runtime.Object, wide bool) (*metav1.Table, error) {
+	chassisList, ok := obj.(*apiv1alpha1.ChassisList)
+	if !ok {
+		return nil, fmt.Errorf("expected ChassisList, got %T", obj)
+	}
+
+	// Define columns for standard view
+	columns := []metav1.TableColumnDefinition{
+		{Name: "NAME", Type: "string", Description: "Chassis name"},
+		{Name: "HOSTNAME", Type: "string", Description: "Hostname of the chassis"},
+		{Name: "ENCAP-IPS", Type: "string", Description: "Tunnel encapsulation IP addresses"},
+		{Name: "GATEWAY", Type: "boolean", Description: "Chassis can host gateway router ports"},
+		{Name: "ROUTERS", Type: "integer", Description: "Number of active routers hosted on the chassis"},
+		{Name: "ALIVE", Type: "boolean", Description: "Chassis is keeping up with nb_cfg updates"},
+	}
+	if wide {
+		columns = append(columns,
+			metav1.TableColumnDefinition{Name: "NB-CFG-LAG", Type: "integer", Description: "Number of nb_cfg updates the chassis is behind"},
+			metav1.TableColumnDefinition{Name: "LAST-SEEN", Type: "string", Description: "Time since the chassis last caught up with nb_cfg"},
+			metav1.TableColumnDefinition{Name: "UUID", Type: "string", Description: "Southbound chassis UUID"},
+		)
+	}
+
+	// Build rows
+	rows := []metav1.TableRow{}
+	for _, chassis := range chassisList.Items {
+		hostname := chassis.Status.Hostname
+		if hostname == "" {
+			hostname = "<none>"
+		}
+
+		encapIPs := "<none>"
+		if len(chassis.Status.EncapIPs) > 0 {
+			encapIPs = strings.Join(chassis.Status.EncapIPs, ",")
+		}
+
+		cells := []interface{}{
+			chassis.Name,
+			hostname,
+			encapIPs,
+			chassis.Status.GatewayCapable,
+			chassis.Status.ActiveRouters,
+			chassis.Status.Alive,
+		}
+		if wide {
+			lastSeen := "<unknown>"
+			if chassis.Status.LastSeen != nil {
+				lastSeen = duration.HumanDuration(time.Since(chassis.Status.LastSeen.Time))
+			}
+
+			cells = append(cells, chassis.Status.NbCfgLag, lastSeen, string(chassis.UID))
+		}
+
+		rows = append(rows, metav1.TableRow{Cells: cells})
+	}
+
+	// Create and return table
+	return &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Table",
+			APIVersion: "meta.k8s.io/v1",
+		},
+		ColumnDefinitions: columns,
+		Rows:              rows,
+	}, nil
+}
@@ -0,0 +1,125 @@
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+)
+
+func testChassis() *apiv1alpha1.ChassisList {
+	return &apiv1alpha1.ChassisList{
+		Items: []apiv1alpha1.Chassis{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "cmp-1", UID: types.UID("uuid-cmp-1")},
+				Status: apiv1alpha1.ChassisStatus{
+					Hostname: "compute-1",
+					EncapIPs: []string{"10.0.0.1"},
+					Alive:    true,
+					LastSeen: &metav1.Time{Time: time.Now()},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "gwc-1", UID: types.UID("uuid-gwc-1")},
+				Status: apiv1alpha1.ChassisStatus{
+					Hostname:       "gateway-1",
+					EncapIPs:       []string{"10.0.0.2", "10.0.1.2"},
+					GatewayCapable: true,
+					Alive:          true,
+					ActiveRouters:  3,
+					LastSeen:       &metav1.Time{Time: time.Now()},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "gwc-2", UID: types.UID("uuid-gwc-2")},
+				Status: apiv1alpha1.ChassisStatus{
+					GatewayCapable: true,
+					NbCfgLag:       5,
+					LastSeen:       &metav1.Time{Time: time.Now().Add(-10 * time.Minute)},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "gwc-3", UID: types.UID("uuid-gwc-3")},
+				Status: apiv1alpha1.ChassisStatus{
+					Hostname:       "gateway-3",
+					GatewayCapable: true,
+					NbCfgLag:       1,
+				},
+			},
+		},
+	}
+}
+
+func TestChassisResource_GetTable(t *testing.T) {
+	tests := []struct {
+		name     string
+		wide     bool
+		columns  []string
+		expected [][]interface{}
+	}{
+		{
+			name:    "standard",
+			columns: []string{"NAME", "HOSTNAME", "ENCAP-IPS", "GATEWAY", "ROUTERS", "ALIVE"},
+			expected: [][]interface{}{
+				{"cmp-1", "compute-1", "10.0.0.1", false, 0, true},
+				{"gwc-1", "gateway-1", "10.0.0.2,10.0.1.2", true, 3, true},
+				{"gwc-2", "<none>", "<none>", true, 0, false},
+				{"gwc-3", "gateway-3", "<none>", true, 0, false},
+			},
+		},
+		{
+			name:    "wide",
+			wide:    true,
+			columns: []string{"NAME", "HOSTNAME", "ENCAP-IPS", "GATEWAY", "ROUTERS", "ALIVE", "NB-CFG-LAG", "LAST-SEEN", "UUID"},
+			expected: [][]interface{}{
+				{"cmp-1", "compute-1", "10.0.0.1", false, 0, true, 0, "0s", "uuid-cmp-1"},
+				{"gwc-1", "gateway-1", "10.0.0.2,10.0.1.2", true, 3, true, 0, "0s", "uuid-gwc-1"},
+				{"gwc-2", "<none>", "<none>", true, 0, false, 5, "10m", "uuid-gwc-2"},
+				{"gwc-3", "gateway-3", "<none>", true, 0, false, 1, "<unknown>", "uuid-gwc-3"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := &ChassisResource{}
+
+			var table *metav1.Table
+			var err error
+			if tt.wide {
+				table, err = resource.GetWideTable(testChassis())
+			} else {
+				table, err = resource.GetTable(testChassis())
+			}
+			require.NoError(t, err)
+
+			var columns []string
+			for _, column := range table.ColumnDefinitions {
+				columns = append(columns, column.Name)
+			}
+			assert.Equal(t, tt.columns, columns)
+
+			var rows [][]interface{}
+			for _, row := range table.Rows {
+				rows = append(rows, row.Cells)
+			}
+			assert.Equal(t, tt.expected, rows)
+		})
+	}
+}
+
+func TestChassisResource_GetTableEmpty(t *testing.T) {
+	table, err := (&ChassisResource{}).GetTable(&apiv1alpha1.ChassisList{})
+	require.NoError(t, err)
+	assert.Empty(t, table.Rows)
+}
+
+func TestChassisResource_GetTableInvalidType(t *testing.T) {
+	_, err := (&ChassisResource{}).GetTable(&apiv1alpha1.RouterList{})
+	assert.EqualError(t, err, "expected ChassisList, got *v1alpha1.RouterList")
+}
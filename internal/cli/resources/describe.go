@@ -0,0 +1,61 @@
+package resources
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// describeIndent is the indentation used for each level of a description
+const describeIndent = "  "
+
+// describeWriter writes kubectl-describe style descriptions, aligning the
+// values of the lines written between two flushes
+type describeWriter struct {
+	out *tabwriter.Writer
+}
+
+// newDescribeWriter creates a describeWriter writing to out
+func newDescribeWriter(out io.Writer) *describeWriter {
+	return &describeWriter{
+		out: tabwriter.NewWriter(out, 0, 8, 2, ' ', 0),
+	}
+}
+
+// Write writes a line indented for the given level, tabs in the format
+// separate the columns which are aligned
+func (w *describeWriter) Write(level int, format string, args ...interface{}) {
+	fmt.Fprintf(w.out, strings.Repeat(describeIndent, level)+format, args...)
+}
+
+// Section writes the title of a section followed by its rows as an aligned
+// table, or `<none>` when there are no rows
+func (w *describeWriter) Section(title string, headers []string, rows [][]string) {
+	if len(rows) == 0 {
+		w.Write(0, "%s:\t<none>\n", title)
+		return
+	}
+
+	w.Write(0, "%s:\n", title)
+
+	separators := make([]string, len(headers))
+	for i, header := range headers {
+		separators[i] = strings.Repeat("-", len(header))
+	}
+
+	w.Write(1, "%s\n", strings.Join(headers, "\t"))
+	w.Write(1, "%s\n", strings.Join(separators, "\t"))
+	for _, row := range rows {
+		w.Write(1, "%s\n", strings.Join(row, "\t"))
+	}
+
+	// Align the table on its own so the following lines are not aligned with
+	// its first column
+	_ = w.out.Flush()
+}
+
+// Flush writes the buffered lines to the underlying writer
+func (w *describeWriter) Flush() error {
+	return w.out.Flush()
+}
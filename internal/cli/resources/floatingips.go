@@ -0,0 +1,145 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ovn-org/libovsdb/client"
+	"github.com/ovn-org/libovsdb/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+	"github.com/vexxhost/atmosphere/internal/ovnfloatingip"
+)
+
+// FloatingIPResource handles floating IP resources
+type FloatingIPResource struct{}
+
+// Name returns the resource name
+func (f *FloatingIPResource) Name() string {
+	return "floatingips"
+}
+
+// Aliases returns alternative names for the resource
+func (f *FloatingIPResource) Aliases() []string {
+	return []string{"floatingip", "fip", "fips"}
+}
+
+// NorthboundTables returns the northbound tables needed to list floating IPs
+func (f *FloatingIPResource) NorthboundTables() map[string]model.Model {
+	return ovnfloatingip.NorthboundTables()
+}
+
+// SouthboundTables returns the southbound tables needed to find the chassis
+// serving distributed floating IPs and hosting routers, which are only needed
+// when there are distributed floating IPs or the northbound schema predates
+// the status of router ports
+func (f *FloatingIPResource) SouthboundTables(nb client.Client) map[string]model.Model {
+	if !ovnfloatingip.NeedsSouthbound(nb) {
+		return nil
+	}
+
+	return ovnfloatingip.SouthboundTables()
+}
+
+// List fetches floating IPs and returns them as a runtime.Object
+func (f *FloatingIPResource) List(ctx context.Context, clients *Clients, names []string) (runtime.Object, error) {
+	fipManager := ovnfloatingip.NewManager(clients.NB, ovnfloatingip.WithSouthbound(clients.SB))
+
+	fipList, err := fipManager.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list floating IPs: %w", err)
+	}
+
+	// Filter by UUID or floating IP address if specified
+	if len(names) > 0 {
+		filtered := []apiv1alpha1.FloatingIP{}
+		nameSet := make(map[string]bool)
+		for _, name := range names {
+			nameSet[name] = true
+		}
+
+		for _, fip := range fipList.Items {
+			if nameSet[string(fip.UID)] || nameSet[fip.Status.FloatingIP] {
+				filtered = append(filtered, fip)
+			}
+		}
+		fipList.Items = filtered
+	}
+
+	// Sort floating IPs by UUID for consistent output
+	sort.Slice(fipList.Items, func(i, j int) bool {
+		return string(fipList.Items[i].UID) < string(fipList.Items[j].UID)
+	})
+
+	return fipList, nil
+}
+
+// GetTable converts a runtime.Object list to a table representation (standard view)
+func (f *FloatingIPResource) GetTable(obj runtime.Object) (*metav1.Table, error) {
+	return f.getTable(obj, false)
+}
+
+// GetWideTable converts a runtime.Object list to a wide table representation
+func (f *FloatingIPResource) GetWideTable(obj runtime.Object) (*metav1.Table, error) {
+	return f.getTable(obj, true)
+}
+
+// getTable builds the standard or wide table representation of floating IPs
+func (f *FloatingIPResource) getTable(obj runtime.Object, wide bool) (*metav1.Table, error) {
+	fipList, ok := obj.(*apiv1alpha1.FloatingIPList)
+	if !ok {
+		return nil, fmt.Errorf("expected FloatingIPList, got %T", obj)
+	}
+
+	// Define columns for standard view
+	columns := []metav1.TableColumnDefinition{
+		{Name: "UUID", Type: "string", Description: "Floating IP UUID"},
+		{Name: "FLOATING-IP", Type: "string", Description: "Floating IP address"},
+		{Name: "FIXED-IP", Type: "string", Description: "Fixed IP address the floating IP is translated to"},
+		{Name: "ROUTER", Type: "string", Description: "Router UUID"},
+		{Name: "PORT", Type: "string", Description: "Port the floating IP is associated with"},
+		{Name: "CHASSIS", Type: "string", Description: "Chassis currently serving the floating IP"},
+	}
+	if wide {
+		columns = append(columns,
+			metav1.TableColumnDefinition{Name: "MODE", Type: "string", Description: "Whether the floating IP is centralized or distributed"},
+			metav1.TableColumnDefinition{Name: "EXTERNAL-MAC", Type: "string", Description: "MAC address used by distributed floating IPs"},
+		)
+	}
+
+	// Build rows
+	rows := []metav1.TableRow{}
+	for _, fip := range fipList.Items {
+		cells := []interface{}{
+			string(fip.UID),
+			fip.Status.FloatingIP,
+			fip.Status.FixedIP,
+			valueOrNone(string(fip.Status.RouterID)),
+			valueOrNone(fip.Status.LogicalPort),
+			valueOrNone(fip.Status.Chassis),
+		}
+		if wide {
+			mode := "centralized"
+			if fip.Status.Distributed {
+				mode = "distributed"
+			}
+
+			cells = append(cells, mode, valueOrNone(fip.Status.ExternalMAC))
+		}
+
+		rows = append(rows, metav1.TableRow{Cells: cells})
+	}
+
+	// Create and return table
+	return &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Table",
+			APIVersion: "meta.k8s.io/v1",
+		},
+		ColumnDefinitions: columns,
+		Rows:              rows,
+	}, nil
+}
@@ -0,0 +1,177 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/ovn-org/libovsdb/client"
+	"github.com/ovn-org/libovsdb/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+	"github.com/vexxhost/atmosphere/internal/ovnloadbalancer"
+)
+
+// LoadBalancerResource handles load balancer resources
+type LoadBalancerResource struct{}
+
+// Name returns the resource name
+func (l *LoadBalancerResource) Name() string {
+	return "loadbalancers"
+}
+
+// Aliases returns alternative names for the resource
+func (l *LoadBalancerResource) Aliases() []string {
+	return []string{"loadbalancer", "lb", "lbs"}
+}
+
+// NorthboundTables returns the northbound tables needed to list load balancers
+func (l *LoadBalancerResource) NorthboundTables() map[string]model.Model {
+	return ovnloadbalancer.NorthboundTables()
+}
+
+// SouthboundTables returns the southbound tables needed to get the health
+// check status of members
+func (l *LoadBalancerResource) SouthboundTables(_ client.Client) map[string]model.Model {
+	return ovnloadbalancer.SouthboundTables()
+}
+
+// List fetches load balancers and returns them as a runtime.Object
+func (l *LoadBalancerResource) List(ctx context.Context, clients *Clients, names []string) (runtime.Object, error) {
+	lbManager := ovnloadbalancer.NewManager(clients.NB, ovnloadbalancer.WithSouthbound(clients.SB))
+
+	lbList, err := lbManager.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list load balancers: %w", err)
+	}
+
+	// Filter by UUID if specified
+	if len(names) > 0 {
+		filtered := []apiv1alpha1.LoadBalancer{}
+		uuidSet := make(map[string]bool)
+		for _, uuid := range names {
+			uuidSet[uuid] = true
+		}
+
+		for _, lb := range lbList.Items {
+			if uuidSet[string(lb.UID)] {
+				filtered = append(filtered, lb)
+			}
+		}
+		lbList.Items = filtered
+	}
+
+	// Sort load balancers by UUID for consistent output
+	sort.Slice(lbList.Items, func(i, j int) bool {
+		return string(lbList.Items[i].UID) < string(lbList.Items[j].UID)
+	})
+
+	return lbList, nil
+}
+
+// GetTable converts a runtime.Object list to a table representation (standard view)
+func (l *LoadBalancerResource) GetTable(obj runtime.Object) (*metav1.Table, error) {
+	return l.getTable(obj, false)
+}
+
+// GetWideTable converts a runtime.Object list to a wide table representation
+func (l *LoadBalancerResource) GetWideTable(obj runtime.Object) (*metav1.Table, error) {
+	return l.getTable(obj, true)
+}
+
+// getTable builds the standard or wide table representation of load balancers
+func (l *LoadBalancerResource) getTable(obj runtime.Object, wide bool) (*metav1.Table, error) {
+	lbList, ok := obj.(*apiv1alpha1.LoadBalancerList)
+	if !ok {
+		return nil, fmt.Errorf("expected LoadBalancerList, got %T", obj)
+	}
+
+	// Define columns for standard view
+	columns := []metav1.TableColumnDefinition{
+		{Name: "UUID", Type: "string", Description: "Load balancer UUID from Octavia"},
+		{Name: "VIP", Type: "string", Description: "Virtual IP address"},
+		{Name: "PROTOCOLS", Type: "string", Description: "Protocols of the listeners"},
+		{Name: "LISTENERS", Type: "string", Description: "Virtual IP addresses and ports"},
+		{Name: "MEMBERS", Type: "integer", Description: "Number of backend members"},
+		{Name: "HEALTH", Type: "string", Description: "Number of health checked members which are online"},
+	}
+	if wide {
+		columns = append(columns,
+			metav1.TableColumnDefinition{Name: "FLOATING-IP", Type: "string", Description: "Floating IP address of the virtual IP"},
+			metav1.TableColumnDefinition{Name: "ROUTERS", Type: "string", Description: "Routers the load balancer is attached to"},
+			metav1.TableColumnDefinition{Name: "NETWORKS", Type: "string", Description: "Networks the load balancer is attached to"},
+		)
+	}
+
+	// Build rows
+	rows := []metav1.TableRow{}
+	for _, lb := range lbList.Items {
+		var protocols, listeners []string
+		members, monitored, online := 0, 0, 0
+		for _, listener := range lb.Status.Listeners {
+			if !slices.Contains(protocols, listener.Protocol) {
+				protocols = append(protocols, listener.Protocol)
+			}
+			listeners = append(listeners, listener.VIP)
+
+			for _, member := range listener.Members {
+				members++
+
+				if listener.HealthChecked {
+					monitored++
+					if member.Status == "online" {
+						online++
+					}
+				}
+			}
+		}
+
+		health := "<none>"
+		if monitored > 0 {
+			health = fmt.Sprintf("%d/%d online", online, monitored)
+		}
+
+		cells := []interface{}{
+			string(lb.UID),
+			valueOrNone(lb.Status.VIP),
+			valueOrNone(strings.Join(protocols, ",")),
+			valueOrNone(strings.Join(listeners, ",")),
+			members,
+			health,
+		}
+		if wide {
+			cells = append(cells,
+				valueOrNone(lb.Status.FloatingIP),
+				valueOrNone(joinUIDs(lb.Status.Routers)),
+				valueOrNone(joinUIDs(lb.Status.Networks)),
+			)
+		}
+
+		rows = append(rows, metav1.TableRow{Cells: cells})
+	}
+
+	// Create and return table
+	return &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Table",
+			APIVersion: "meta.k8s.io/v1",
+		},
+		ColumnDefinitions: columns,
+		Rows:              rows,
+	}, nil
+}
+
+// joinUIDs joins UIDs with commas
+func joinUIDs(uids []types.UID) string {
+	values := make([]string, 0, len(uids))
+	for _, uid := range uids {
+		values = append(values, string(uid))
+	}
+
+	return strings.Join(values, ",")
+}
@@ -0,0 +1,147 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/ovn-org/libovsdb/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+	"github.com/vexxhost/atmosphere/internal/ovnnetwork"
+)
+
+// NetworkResource handles network resources
+type NetworkResource struct{}
+
+// Name returns the resource name
+func (n *NetworkResource) Name() string {
+	return "networks"
+}
+
+// Aliases returns alternative names for the resource
+func (n *NetworkResource) Aliases() []string {
+	return []string{"network", "net"}
+}
+
+// NorthboundTables returns the northbound tables needed to list networks
+func (n *NetworkResource) NorthboundTables() map[string]model.Model {
+	return ovnnetwork.Tables()
+}
+
+// List fetches networks and returns them as a runtime.Object
+func (n *NetworkResource) List(ctx context.Context, clients *Clients, names []string) (runtime.Object, error) {
+	networkManager := ovnnetwork.NewManager(clients.NB)
+
+	networkList, err := networkManager.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list networks: %w", err)
+	}
+
+	// Filter by UUID if specified
+	if len(names) > 0 {
+		filtered := []apiv1alpha1.Network{}
+		uuidSet := make(map[string]bool)
+		for _, uuid := range names {
+			uuidSet[uuid] = true
+		}
+
+		for _, network := range networkList.Items {
+			if uuidSet[string(network.UID)] {
+				filtered = append(filtered, network)
+			}
+		}
+		networkList.Items = filtered
+	}
+
+	// Sort networks by UUID for consistent output
+	sort.Slice(networkList.Items, func(i, j int) bool {
+		return string(networkList.Items[i].UID) < string(networkList.Items[j].UID)
+	})
+
+	return networkList, nil
+}
+
+// GetTable converts a runtime.Object list to a table representation (standard view)
+func (n *NetworkResource) GetTable(obj runtime.Object) (*metav1.Table, error) {
+	return n.getTable(obj, false)
+}
+
+// GetWideTable converts a runtime.Object list to a wide table representation
+func (n *NetworkResource) GetWideTable(obj runtime.Object) (*metav1.Table, error) {
+	return n.getTable(obj, true)
+}
+
+// getTable builds the standard or wide table representation of networks
+func (n *NetworkResource) getTable(obj runtime.Object, wide bool) (*metav1.Table, error) {
+	networkList, ok := obj.(*apiv1alpha1.NetworkList)
+	if !ok {
+		return nil, fmt.Errorf("expected NetworkList, got %T", obj)
+	}
+
+	// Define columns for standard view
+	columns := []metav1.TableColumnDefinition{
+		{Name: "UUID", Type: "string", Description: "Network UUID"},
+		{Name: "NAME", Type: "string", Description: "Network name from Neutron"},
+		{Name: "TYPE", Type: "string", Description: "Provider network type"},
+		{Name: "MTU", Type: "integer", Description: "Network MTU"},
+		{Name: "PORTS", Type: "integer", Description: "Number of ports"},
+	}
+	if wide {
+		columns = append(columns,
+			metav1.TableColumnDefinition{Name: "PHYSICAL-NETWORK", Type: "string", Description: "Provider physical network"},
+			metav1.TableColumnDefinition{Name: "SEGMENTATION-ID", Type: "string", Description: "Provider segmentation ID"},
+			metav1.TableColumnDefinition{Name: "INTERNAL-UUID", Type: "string", Description: "Logical switch UUID"},
+		)
+	}
+
+	// Build rows
+	rows := []metav1.TableRow{}
+	for _, network := range networkList.Items {
+		mtu := "<none>"
+		if network.Status.MTU > 0 {
+			mtu = strconv.Itoa(network.Status.MTU)
+		}
+
+		cells := []interface{}{
+			string(network.UID),
+			network.Name,
+			network.Status.NetworkType,
+			mtu,
+			network.Status.Ports,
+		}
+		if wide {
+			physicalNetwork := "<none>"
+			if network.Status.PhysicalNetwork != "" {
+				physicalNetwork = network.Status.PhysicalNetwork
+			}
+
+			segmentationID := "<none>"
+			if network.Status.SegmentationID != nil {
+				segmentationID = strconv.Itoa(*network.Status.SegmentationID)
+			}
+
+			internalUUID := "<none>"
+			if network.Status.InternalUUID != nil {
+				internalUUID = string(*network.Status.InternalUUID)
+			}
+
+			cells = append(cells, physicalNetwork, segmentationID, internalUUID)
+		}
+
+		rows = append(rows, metav1.TableRow{Cells: cells})
+	}
+
+	// Create and return table
+	return &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Table",
+			APIVersion: "meta.k8s.io/v1",
+		},
+		ColumnDefinitions: columns,
+		Rows:              rows,
+	}, nil
+}
@@ -0,0 +1,175 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ovn-org/libovsdb/client"
+	"github.com/ovn-org/libovsdb/model"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+	"github.com/vexxhost/atmosphere/internal/ovnport"
+)
+
+// PortResource handles port resources
+type PortResource struct {
+	network  string
+	deviceID string
+}
+
+// Name returns the resource name
+func (p *PortResource) Name() string {
+	return "ports"
+}
+
+// Aliases returns alternative names for the resource
+func (p *PortResource) Aliases() []string {
+	return []string{"port"}
+}
+
+// AddFlags adds the flags used to select ports
+func (p *PortResource) AddFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&p.network, "network", "", "Only list the ports attached to the given network UUID (ports only)")
+	flags.StringVar(&p.deviceID, "device-id", "", "Only list the ports used by the given device, such as a server UUID (ports only)")
+}
+
+// NorthboundTables returns the northbound tables needed to list ports
+func (p *PortResource) NorthboundTables() map[string]model.Model {
+	return ovnport.NorthboundTables()
+}
+
+// SouthboundTables returns the southbound tables needed to find the chassis
+// ports are bound to
+func (p *PortResource) SouthboundTables(_ client.Client) map[string]model.Model {
+	return ovnport.SouthboundTables()
+}
+
+// List fetches ports and returns them as a runtime.Object
+func (p *PortResource) List(ctx context.Context, clients *Clients, names []string) (runtime.Object, error) {
+	portManager := ovnport.NewManager(clients.NB, ovnport.WithSouthbound(clients.SB))
+
+	portList, err := portManager.List(ctx, ovnport.ListOptions{
+		NetworkID: types.UID(p.network),
+		DeviceID:  p.deviceID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ports: %w", err)
+	}
+
+	// Filter by UUID if specified
+	if len(names) > 0 {
+		filtered := []apiv1alpha1.Port{}
+		uuidSet := make(map[string]bool)
+		for _, uuid := range names {
+			uuidSet[uuid] = true
+		}
+
+		for _, port := range portList.Items {
+			if uuidSet[string(port.UID)] {
+				filtered = append(filtered, port)
+			}
+		}
+		portList.Items = filtered
+	}
+
+	// Sort ports by UUID for consistent output
+	sort.Slice(portList.Items, func(i, j int) bool {
+		return string(portList.Items[i].UID) < string(portList.Items[j].UID)
+	})
+
+	return portList, nil
+}
+
+// GetTable converts a runtime.Object list to a table representation (standard view)
+func (p *PortResource) GetTable(obj runtime.Object) (*metav1.Table, error) {
+	return p.getTable(obj, false)
+}
+
+// GetWideTable converts a runtime.Object list to a wide table representation
+func (p *PortResource) GetWideTable(obj runtime.Object) (*metav1.Table, error) {
+	return p.getTable(obj, true)
+}
+
+// getTable builds the standard or wide table representation of ports
+func (p *PortResource) getTable(obj runtime.Object, wide bool) (*metav1.Table, error) {
+	portList, ok := obj.(*apiv1alpha1.PortList)
+	if !ok {
+		return nil, fmt.Errorf("expected PortList, got %T", obj)
+	}
+
+	// Define columns for standard view
+	columns := []metav1.TableColumnDefinition{
+		{Name: "UUID", Type: "string", Description: "Port UUID"},
+		{Name: "NAME", Type: "string", Description: "Port name from Neutron"},
+		{Name: "MAC-ADDRESS", Type: "string", Description: "MAC address"},
+		{Name: "IP-ADDRESSES", Type: "string", Description: "IP addresses (IPv4 and IPv6)"},
+		{Name: "STATUS", Type: "string", Description: "Port status reported by OVN"},
+		{Name: "CHASSIS", Type: "string", Description: "Chassis the port is bound to"},
+	}
+	if wide {
+		columns = append(columns,
+			metav1.TableColumnDefinition{Name: "NETWORK", Type: "string", Description: "Network UUID"},
+			metav1.TableColumnDefinition{Name: "DEVICE-OWNER", Type: "string", Description: "Neutron device owner"},
+			metav1.TableColumnDefinition{Name: "DEVICE-ID", Type: "string", Description: "Device UUID"},
+		)
+	}
+
+	// Build rows
+	rows := []metav1.TableRow{}
+	for _, port := range portList.Items {
+		cells := []interface{}{
+			string(port.UID),
+			port.Name,
+			valueOrNone(port.Status.MACAddress),
+			valueOrNone(strings.Join(port.Status.IPAddresses, ",")),
+			portStatus(port.Status.Up),
+			valueOrNone(port.Status.Chassis),
+		}
+		if wide {
+			cells = append(cells,
+				valueOrNone(string(port.Status.NetworkID)),
+				valueOrNone(port.Status.DeviceOwner),
+				valueOrNone(port.Status.DeviceID),
+			)
+		}
+
+		rows = append(rows, metav1.TableRow{Cells: cells})
+	}
+
+	// Create and return table
+	return &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Table",
+			APIVersion: "meta.k8s.io/v1",
+		},
+		ColumnDefinitions: columns,
+		Rows:              rows,
+	}, nil
+}
+
+// portStatus formats the up state of a port
+func portStatus(up *bool) string {
+	switch {
+	case up == nil:
+		return "UNKNOWN"
+	case *up:
+		return "ACTIVE"
+	default:
+		return "DOWN"
+	}
+}
+
+// valueOrNone returns the value or "<none>" if it is empty
+func valueOrNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+
+	return value
+}
@@ -5,6 +5,8 @@ import (
 	"io"
 
 	"github.com/ovn-org/libovsdb/client"
+	"github.com/ovn-org/libovsdb/model"
+	"github.com/spf13/pflag"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
@@ -22,6 +24,16 @@ type ResourceList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 }
 
+// Clients holds the OVN database clients used to fetch resources
+type Clients struct {
+	// NB is the client for the northbound database
+	NB client.Client
+
+	// SB is the client for the southbound database, it is only set for
+	// resources implementing SouthboundResource which need it
+	SB client.Client
+}
+
 // Resource defines the interface for a resource that can be fetched
 type Resource interface {
 	// Name returns the resource name (e.g., "routers")
@@ -30,13 +42,45 @@ type Resource interface {
 	// Aliases returns alternative names for the resource (e.g., ["router"] for "routers")
 	Aliases() []string
 
+	// NorthboundTables returns the northbound tables which must be monitored to
+	// list the resource
+	NorthboundTables() map[string]model.Model
+
 	// List fetches resources and returns them as a runtime.Object list
-	List(ctx context.Context, client client.Client, names []string) (runtime.Object, error)
+	List(ctx context.Context, clients *Clients, names []string) (runtime.Object, error)
 
 	// GetTable converts a runtime.Object list to a table representation
 	GetTable(obj runtime.Object) (*metav1.Table, error)
 }
 
+// SouthboundResource is implemented by resources which can also need the
+// southbound database
+type SouthboundResource interface {
+	// SouthboundTables returns the southbound tables which must be monitored
+	// to list the resource from the given northbound client, or nil if the
+	// southbound database is not needed
+	SouthboundTables(nb client.Client) map[string]model.Model
+}
+
+// FlagsResource is implemented by resources which accept additional flags to
+// select the resources to list
+type FlagsResource interface {
+	// AddFlags adds the resource specific flags to the flag set
+	AddFlags(flags *pflag.FlagSet)
+}
+
+// DescribeResource is implemented by resources which can print a detailed
+// description of a single resource
+type DescribeResource interface {
+	// DescribeNorthboundTables returns the northbound tables which must be
+	// monitored to describe the resource
+	DescribeNorthboundTables() map[string]model.Model
+
+	// Describe writes a human readable description of the resource with the
+	// given name
+	Describe(ctx context.Context, clients *Clients, name string, out io.Writer) error
+}
+
 // Registry holds all registered resources
 type Registry struct {
 	resources map[string]Resource
@@ -82,8 +126,9 @@ func (r *Registry) List() []string {
 
 // OVNConfig holds OVN connection configuration
 type OVNConfig struct {
-	Endpoints []string
-	Namespace string
+	Endpoints   []string
+	SBEndpoints []string
+	Namespace   string
 
 	// For northbound database
 	NBStatefulSet string
@@ -121,8 +166,8 @@ func (c *OVNConfig) GetNBEndpoints() []string {
 
 // GetSBEndpoints returns the southbound database endpoints
 func (c *OVNConfig) GetSBEndpoints() []string {
-	if len(c.Endpoints) > 0 {
-		return c.Endpoints
+	if len(c.SBEndpoints) > 0 {
+		return c.SBEndpoints
 	}
 
 	// Generate default endpoints
@@ -3,19 +3,26 @@ package resources
 import (
 	"context"
 	"fmt"
+	"io"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/ovn-org/libovsdb/client"
+	"github.com/ovn-org/libovsdb/model"
+	"github.com/spf13/pflag"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 
 	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
 	"github.com/vexxhost/atmosphere/internal/ovnrouter"
 )
 
 // RouterResource handles router resources
-type RouterResource struct{}
+type RouterResource struct {
+	project string
+}
 
 // Name returns the resource name
 func (r *RouterResource) Name() string {
@@ -27,10 +34,37 @@ func (r *RouterResource) Aliases() []string {
 	return []string{"router"}
 }
 
+// AddFlags adds the flags used to select routers
+func (r *RouterResource) AddFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&r.project, "project", "", "Only list the routers owned by the given Neutron project ID (routers only)")
+}
+
+// NorthboundTables returns the northbound tables needed to list routers
+func (r *RouterResource) NorthboundTables() map[string]model.Model {
+	return ovnrouter.Tables()
+}
+
+// SouthboundTables returns the southbound tables needed to find the chassis
+// hosting routers, which are only needed when the northbound schema predates
+// the status of router ports
+func (r *RouterResource) SouthboundTables(nb client.Client) map[string]model.Model {
+	if ovnrouter.HasPortStatus(nb) {
+		return nil
+	}
+
+	return ovnrouter.SouthboundTables()
+}
+
+// DescribeNorthboundTables returns the northbound tables needed to describe
+// the topology of a router
+func (r *RouterResource) DescribeNorthboundTables() map[string]model.Model {
+	return ovnrouter.TopologyTables()
+}
+
 // List fetches routers and returns them as a runtime.Object
-func (r *RouterResource) List(ctx context.Context, client client.Client, names []string) (runtime.Object, error) {
+func (r *RouterResource) List(ctx context.Context, clients *Clients, names []string) (runtime.Object, error) {
 	// Create router manager
-	routerManager := ovnrouter.NewManager(client)
+	routerManager := ovnrouter.NewManager(clients.NB, ovnrouter.WithSouthbound(clients.SB))
 
 	// Fetch all routers (they already have external IPs populated)
 	routerList, err := routerManager.List(ctx)
@@ -38,21 +72,16 @@ func (r *RouterResource) List(ctx context.Context, client client.Client, names [
 		return nil, fmt.Errorf("failed to list routers: %w", err)
 	}
 
-	// Filter by UUID if specified
-	if len(names) > 0 {
-		filtered := []apiv1alpha1.Router{}
-		uuidSet := make(map[string]bool)
-		for _, uuid := range names {
-			uuidSet[uuid] = true
-		}
+	if r.project != "" {
+		routerList.Items = ovnrouter.FilterByProject(routerList.Items, r.project)
+	}
 
-		for _, router := range routerList.Items {
-			// Check UUID only
-			if uuidSet[string(router.UID)] {
-				filtered = append(filtered, router)
-			}
+	// Resolve the routers by UUID, name or UUID prefix if specified
+	if len(names) > 0 {
+		routerList.Items, err = ovnrouter.Resolve(routerList.Items, names)
+		if err != nil {
+			return nil, err
 		}
-		routerList.Items = filtered
 	}
 
 	// Sort routers by UUID for consistent output
@@ -135,8 +164,11 @@ func (r *RouterResource) GetWideTable(obj runtime.Object) (*metav1.Table, error)
 		{Name: "NAME", Type: "string", Description: "Router name from Neutron"},
 		{Name: "AGENT", Type: "string", Description: "Current hosting agent"},
 		{Name: "EXTERNAL-IPS", Type: "string", Description: "External IP addresses (IPv4 and IPv6)"},
-		{Name: "ENABLED", Type: "string", Description: "Router enabled status"},
+		{Name: "ENABLED", Type: "boolean", Description: "Router enabled status"},
 		{Name: "PORTS", Type: "integer", Description: "Number of ports"},
+		{Name: "GATEWAY-CHASSIS", Type: "string", Description: "Gateway chassis with their priority, from the highest to the lowest"},
+		{Name: "NATS", Type: "integer", Description: "Number of NAT rules"},
+		{Name: "ROUTES", Type: "integer", Description: "Number of static routes"},
 	}
 
 	// Build rows
@@ -157,9 +189,15 @@ func (r *RouterResource) GetWideTable(obj runtime.Object) (*metav1.Table, error)
 			externalIPs = strings.Join(router.Status.ExternalIPs, ",")
 		}
 
-		// For wide view, we'll simplify for now - no enabled/ports info
-		enabled := "N/A"
-		ports := 0
+		// Format gateway chassis as name(priority)
+		gatewayChassis := "<none>"
+		if len(router.Status.GatewayChassis) > 0 {
+			members := make([]string, 0, len(router.Status.GatewayChassis))
+			for _, member := range router.Status.GatewayChassis {
+				members = append(members, fmt.Sprintf("%s(%d)", member.Name, member.Priority))
+			}
+			gatewayChassis = strings.Join(members, ",")
+		}
 
 		row := metav1.TableRow{
 			Cells: []interface{}{
@@ -167,8 +205,11 @@ func (r *RouterResource) GetWideTable(obj runtime.Object) (*metav1.Table, error)
 				routerName,
 				agent,
 				externalIPs,
-				enabled,
-				ports,
+				router.Status.Enabled,
+				len(router.Status.Ports),
+				gatewayChassis,
+				router.Status.NATs,
+				router.Status.StaticRoutes,
 			},
 		}
 		rows = append(rows, row)
@@ -184,3 +225,96 @@ func (r *RouterResource) GetWideTable(obj runtime.Object) (*metav1.Table, error)
 		Rows:              rows,
 	}, nil
 }
+
+// Describe writes the ports, gateway chassis, NAT rules, static routes,
+// attached networks and load balancers of a router
+func (r *RouterResource) Describe(ctx context.Context, clients *Clients, name string, out io.Writer) error {
+	routerManager := ovnrouter.NewManager(clients.NB, ovnrouter.WithSouthbound(clients.SB))
+
+	// Resolve the router by UUID, name or UUID prefix like the get command
+	routerList, err := routerManager.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list routers: %w", err)
+	}
+
+	resolved, err := ovnrouter.Resolve(routerList.Items, []string{name})
+	if err != nil {
+		return err
+	}
+	router := &resolved[0]
+
+	topology, err := routerManager.GetTopology(ctx, router)
+	if err != nil {
+		return err
+	}
+
+	w := newDescribeWriter(out)
+	w.Write(0, "Name:\t%s\n", router.Name)
+	w.Write(0, "UUID:\t%s\n", router.UID)
+	w.Write(0, "Internal UUID:\t%s\n", valueOrNone(string(ptr.Deref(router.Status.InternalUUID, ""))))
+	w.Write(0, "Enabled:\t%t\n", topology.Enabled)
+	w.Write(0, "Agent:\t%s\n", valueOrNone(router.Status.Agent))
+	w.Write(0, "External IPs:\t%s\n", valueOrNone(strings.Join(router.Status.ExternalIPs, ", ")))
+
+	ports := make([][]string, 0, len(topology.Ports))
+	for _, port := range topology.Ports {
+		ports = append(ports, []string{
+			port.Name,
+			port.MAC,
+			valueOrNone(strings.Join(port.Addresses, ",")),
+			valueOrNone(port.Network),
+			strconv.FormatBool(port.IsGateway),
+		})
+	}
+	w.Section("Ports", []string{"NAME", "MAC", "ADDRESSES", "NETWORK", "GATEWAY"}, ports)
+
+	gatewayChassis := make([][]string, 0, len(topology.GatewayChassis))
+	for _, member := range topology.GatewayChassis {
+		gatewayChassis = append(gatewayChassis, []string{
+			member.ChassisName,
+			strconv.Itoa(member.Priority),
+			strconv.FormatBool(member.Active),
+		})
+	}
+	gatewayTitle := "Gateway Chassis"
+	if topology.HAChassisGroup {
+		gatewayTitle = "HA Chassis Group"
+	}
+	w.Section(gatewayTitle, []string{"CHASSIS", "PRIORITY", "ACTIVE"}, gatewayChassis)
+
+	nats := make([][]string, 0, len(topology.NATs))
+	for _, nat := range topology.NATs {
+		nats = append(nats, []string{
+			nat.Type,
+			nat.ExternalIP,
+			nat.LogicalIP,
+			valueOrNone(nat.LogicalPort),
+		})
+	}
+	w.Section("NAT Rules", []string{"TYPE", "EXTERNAL-IP", "LOGICAL-IP", "LOGICAL-PORT"}, nats)
+
+	routes := make([][]string, 0, len(topology.StaticRoutes))
+	for _, route := range topology.StaticRoutes {
+		routes = append(routes, []string{
+			route.IPPrefix,
+			route.Nexthop,
+			valueOrNone(route.OutputPort),
+			route.Policy,
+		})
+	}
+	w.Section("Static Routes", []string{"PREFIX", "NEXTHOP", "OUTPUT-PORT", "POLICY"}, routes)
+
+	networks := make([][]string, 0, len(topology.Networks))
+	for _, network := range topology.Networks {
+		networks = append(networks, []string{network})
+	}
+	w.Section("Networks", []string{"UUID"}, networks)
+
+	loadBalancers := make([][]string, 0, len(topology.LoadBalancers))
+	for _, lb := range topology.LoadBalancers {
+		loadBalancers = append(loadBalancers, []string{lb})
+	}
+	w.Section("Load Balancers", []string{"UUID"}, loadBalancers)
+
+	return w.Flush()
+}
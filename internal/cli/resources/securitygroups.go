@@ -0,0 +1,157 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ovn-org/libovsdb/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+	"github.com/vexxhost/atmosphere/internal/ovnsecuritygroup"
+)
+
+// SecurityGroupResource handles security group resources
+type SecurityGroupResource struct{}
+
+// Name returns the resource name
+func (s *SecurityGroupResource) Name() string {
+	return "securitygroups"
+}
+
+// Aliases returns alternative names for the resource
+func (s *SecurityGroupResource) Aliases() []string {
+	return []string{"securitygroup", "sg", "sgs"}
+}
+
+// NorthboundTables returns the northbound tables needed to list security groups
+func (s *SecurityGroupResource) NorthboundTables() map[string]model.Model {
+	return ovnsecuritygroup.Tables()
+}
+
+// List fetches security groups and returns them as a runtime.Object
+func (s *SecurityGroupResource) List(ctx context.Context, clients *Clients, names []string) (runtime.Object, error) {
+	sgManager := ovnsecuritygroup.NewManager(clients.NB)
+
+	sgList, err := sgManager.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list security groups: %w", err)
+	}
+
+	// Filter by UUID if specified
+	if len(names) > 0 {
+		filtered := []apiv1alpha1.SecurityGroup{}
+		nameSet := make(map[string]bool)
+		for _, name := range names {
+			nameSet[name] = true
+		}
+
+		for _, sg := range sgList.Items {
+			if nameSet[string(sg.UID)] {
+				filtered = append(filtered, sg)
+			}
+		}
+		sgList.Items = filtered
+	}
+
+	// Sort security groups by UUID for consistent output
+	sort.Slice(sgList.Items, func(i, j int) bool {
+		return string(sgList.Items[i].UID) < string(sgList.Items[j].UID)
+	})
+
+	return sgList, nil
+}
+
+// GetTable converts a runtime.Object list to a table representation (standard view)
+func (s *SecurityGroupResource) GetTable(obj runtime.Object) (*metav1.Table, error) {
+	sgList, ok := obj.(*apiv1alpha1.SecurityGroupList)
+	if !ok {
+		return nil, fmt.Errorf("expected SecurityGroupList, got %T", obj)
+	}
+
+	// Define columns for standard view
+	columns := []metav1.TableColumnDefinition{
+		{Name: "UUID", Type: "string", Description: "Security group UUID"},
+		{Name: "PORT-GROUP", Type: "string", Description: "OVN port group name"},
+		{Name: "PORTS", Type: "integer", Description: "Number of member ports"},
+		{Name: "ACLS", Type: "integer", Description: "Number of ACLs"},
+	}
+
+	// Build rows
+	rows := []metav1.TableRow{}
+	for _, sg := range sgList.Items {
+		rows = append(rows, metav1.TableRow{
+			Cells: []interface{}{
+				string(sg.UID),
+				sg.Status.PortGroup,
+				sg.Status.Ports,
+				len(sg.Status.ACLs),
+			},
+		})
+	}
+
+	// Create and return table
+	return &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Table",
+			APIVersion: "meta.k8s.io/v1",
+		},
+		ColumnDefinitions: columns,
+		Rows:              rows,
+	}, nil
+}
+
+// GetWideTable converts a runtime.Object list to a wide table representation,
+// expanding every security group into one row per ACL
+func (s *SecurityGroupResource) GetWideTable(obj runtime.Object) (*metav1.Table, error) {
+	sgList, ok := obj.(*apiv1alpha1.SecurityGroupList)
+	if !ok {
+		return nil, fmt.Errorf("expected SecurityGroupList, got %T", obj)
+	}
+
+	// Define columns for wide view
+	columns := []metav1.TableColumnDefinition{
+		{Name: "UUID", Type: "string", Description: "Security group UUID"},
+		{Name: "RULE", Type: "string", Description: "Security group rule UUID"},
+		{Name: "DIRECTION", Type: "string", Description: "Direction of the traffic from the point of view of the port"},
+		{Name: "PRIORITY", Type: "integer", Description: "Priority of the ACL"},
+		{Name: "ACTION", Type: "string", Description: "Action of the ACL"},
+		{Name: "MATCH", Type: "string", Description: "Match expression of the ACL"},
+	}
+
+	// Build rows
+	rows := []metav1.TableRow{}
+	for _, sg := range sgList.Items {
+		if len(sg.Status.ACLs) == 0 {
+			rows = append(rows, metav1.TableRow{
+				Cells: []interface{}{string(sg.UID), "<none>", "<none>", "<none>", "<none>", "<none>"},
+			})
+			continue
+		}
+
+		for _, acl := range sg.Status.ACLs {
+			rows = append(rows, metav1.TableRow{
+				Cells: []interface{}{
+					string(sg.UID),
+					valueOrNone(string(acl.RuleID)),
+					acl.Direction,
+					acl.Priority,
+					acl.Action,
+					ovnsecuritygroup.FormatMatch(&acl, sg.Status.PortGroup),
+				},
+			})
+		}
+	}
+
+	// Create and return table
+	return &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Table",
+			APIVersion: "meta.k8s.io/v1",
+		},
+		ColumnDefinitions: columns,
+		Rows:              rows,
+	}, nil
+}
@@ -0,0 +1,289 @@
+package resources
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+// Selector filters the resources of a list by their labels, which hold the
+// OVN external_ids of the rows they are built from, and by any of their
+// fields
+type Selector struct {
+	labels []labelRequirement
+	fields fields.Requirements
+}
+
+// labelRequirement is a requirement of a label selector with the key and
+// values given by the user
+type labelRequirement struct {
+	key      string
+	operator selection.Operator
+	values   []string
+}
+
+// NewSelector parses the label and field selectors. The label selector has the
+// syntax of kubectl, such as `key=value`, `key!=value`, `key in (a,b)`,
+// `key notin (a,b)`, `key` or `!key`, and the field selector is a comma
+// separated list of `key=value`, `key==value` or `key!=value` requirements
+// where keys are paths such as `status.agent`.
+func NewSelector(labelSelector, fieldSelector string) (*Selector, error) {
+	labels, err := parseLabelSelector(labelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector %q: %w", labelSelector, err)
+	}
+
+	fieldsSelector, err := fields.ParseSelector(fieldSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid field selector %q: %w", fieldSelector, err)
+	}
+
+	return &Selector{
+		labels: labels,
+		fields: fieldsSelector.Requirements(),
+	}, nil
+}
+
+// parseLabelSelector parses a label selector with labels.Parse. The keys and
+// values are replaced by placeholders before parsing and restored afterwards,
+// since OVN external_ids such as `neutron:device_owner=network:router_gateway`
+// are neither valid Kubernetes label keys nor values.
+func parseLabelSelector(selector string) ([]labelRequirement, error) {
+	var builder strings.Builder
+	literals := map[string]string{}
+
+	for i := 0; i < len(selector); {
+		if isLabelSelectorSymbol(selector[i]) {
+			builder.WriteByte(selector[i])
+			i++
+			continue
+		}
+
+		start := i
+		for i < len(selector) && !isLabelSelectorSymbol(selector[i]) {
+			i++
+		}
+
+		// NOTE: The `in` and `notin` operators are identifiers for the lexer
+		//       and the `>` and `<` operators require integer values, so they
+		//       are kept as is.
+		literal := selector[start:i]
+		if _, err := strconv.ParseInt(literal, 10, 64); err == nil || literal == "in" || literal == "notin" {
+			builder.WriteString(literal)
+			continue
+		}
+
+		placeholder := fmt.Sprintf("l%d", len(literals))
+		literals[placeholder] = literal
+		builder.WriteString(placeholder)
+	}
+
+	parsed, err := labels.Parse(builder.String())
+	if err != nil {
+		return nil, err
+	}
+
+	restore := func(value string) string {
+		if literal, ok := literals[value]; ok {
+			return literal
+		}
+		return value
+	}
+
+	requirements, _ := parsed.Requirements()
+	result := make([]labelRequirement, 0, len(requirements))
+	for _, requirement := range requirements {
+		var values []string
+		for _, value := range requirement.ValuesUnsorted() {
+			values = append(values, restore(value))
+		}
+
+		result = append(result, labelRequirement{
+			key:      restore(requirement.Key()),
+			operator: requirement.Operator(),
+			values:   values,
+		})
+	}
+
+	return result, nil
+}
+
+// isLabelSelectorSymbol returns true if the lexer of labels.Parse splits
+// identifiers on the character
+func isLabelSelectorSymbol(ch byte) bool {
+	return strings.IndexByte(" \t\r\n=!(),><", ch) >= 0
+}
+
+// Empty returns true if the selector matches every resource
+func (s *Selector) Empty() bool {
+	return len(s.labels) == 0 && len(s.fields) == 0
+}
+
+// Filter removes the resources which do not match the selector from the list
+func (s *Selector) Filter(list runtime.Object) error {
+	if s.Empty() {
+		return nil
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return fmt.Errorf("failed to extract resources from list: %w", err)
+	}
+
+	filtered := make([]runtime.Object, 0, len(items))
+	for _, item := range items {
+		matches, err := s.Matches(item)
+		if err != nil {
+			return err
+		}
+
+		if matches {
+			filtered = append(filtered, item)
+		}
+	}
+
+	return meta.SetList(list, filtered)
+}
+
+// Matches returns true if the resource matches the selector
+func (s *Selector) Matches(obj runtime.Object) (bool, error) {
+	if len(s.labels) > 0 {
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			return false, fmt.Errorf("failed to access metadata of resource: %w", err)
+		}
+
+		labels := accessor.GetLabels()
+		for _, requirement := range s.labels {
+			if !requirement.matches(labels) {
+				return false, nil
+			}
+		}
+	}
+
+	if len(s.fields) > 0 {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return false, fmt.Errorf("failed to convert %T: %w", obj, err)
+		}
+
+		for _, requirement := range s.fields {
+			if !matchesRequirement(requirement, fieldValues(content, requirement.Field)) {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// matches returns true if the labels match the requirement like with kubectl,
+// except that a label holding a comma separated list, such as
+// `neutron:availability_zone_hints=az1,az2`, is equal to a value if any of
+// its elements is
+func (r labelRequirement) matches(labels map[string]string) bool {
+	label, ok := labels[r.key]
+
+	switch r.operator {
+	case selection.Exists:
+		return ok
+	case selection.DoesNotExist:
+		return !ok
+	case selection.GreaterThan, selection.LessThan:
+		if !ok || len(r.values) != 1 {
+			return false
+		}
+
+		value, err := strconv.ParseInt(label, 10, 64)
+		if err != nil {
+			return false
+		}
+
+		limit, err := strconv.ParseInt(r.values[0], 10, 64)
+		if err != nil {
+			return false
+		}
+
+		if r.operator == selection.GreaterThan {
+			return value > limit
+		}
+		return value < limit
+	}
+
+	found := false
+	if ok {
+		found = slices.ContainsFunc(strings.Split(label, ","), func(value string) bool {
+			return slices.Contains(r.values, value)
+		})
+	}
+
+	if r.operator == selection.NotEquals || r.operator == selection.NotIn {
+		return !found
+	}
+
+	return found
+}
+
+// matchesRequirement returns true if the values of a key match the
+// requirement, a key with multiple values, such as a list of IP addresses,
+// is equal to a value if any of its values is
+func matchesRequirement(requirement fields.Requirement, values []string) bool {
+	found := false
+	for _, value := range values {
+		if value == requirement.Value {
+			found = true
+			break
+		}
+	}
+
+	// NOTE: Missing keys are handled like empty values, so that `key=` selects
+	//       the resources without the key, like with field selectors of the
+	//       Kubernetes API.
+	if len(values) == 0 && requirement.Value == "" {
+		found = true
+	}
+
+	if requirement.Operator == selection.NotEquals {
+		return !found
+	}
+
+	return found
+}
+
+// fieldValues returns the values of the field at the dotted path in the
+// unstructured content of a resource, the elements of a list are returned as
+// separate values
+func fieldValues(content map[string]interface{}, path string) []string {
+	var value interface{} = content
+	for _, key := range strings.Split(strings.TrimPrefix(path, "."), ".") {
+		fields, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+
+		value, ok = fields[key]
+		if !ok {
+			return nil
+		}
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, element := range v {
+			values = append(values, fmt.Sprint(element))
+		}
+		return values
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
@@ -0,0 +1,219 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+)
+
+func testRouters() *apiv1alpha1.RouterList {
+	return &apiv1alpha1.RouterList{
+		Items: []apiv1alpha1.Router{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "router-1",
+					Labels: map[string]string{
+						"neutron:router_name":             "public",
+						"neutron:availability_zone_hints": "az-1,az-2",
+					},
+				},
+				Status: apiv1alpha1.RouterStatus{
+					Agent:       "gwc-1",
+					ExternalIPs: []string{"203.0.113.10", "203.0.113.11"},
+					Enabled:     true,
+					NATs:        2,
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "router-2",
+					Labels: map[string]string{
+						"neutron:router_name": "private",
+					},
+				},
+				Status: apiv1alpha1.RouterStatus{
+					ExternalIPs: []string{"203.0.113.20"},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "router-3",
+				},
+				Status: apiv1alpha1.RouterStatus{
+					Agent:   "gwc-2",
+					Enabled: true,
+				},
+			},
+		},
+	}
+}
+
+func TestSelector(t *testing.T) {
+	tests := []struct {
+		name          string
+		labelSelector string
+		fieldSelector string
+		expected      []string
+		err           string
+	}{
+		{
+			name:     "empty selectors",
+			expected: []string{"router-1", "router-2", "router-3"},
+		},
+		{
+			name:          "neutron label",
+			labelSelector: "neutron:router_name=public",
+			expected:      []string{"router-1"},
+		},
+		{
+			name:          "neutron label with double equals",
+			labelSelector: "neutron:router_name==private",
+			expected:      []string{"router-2"},
+		},
+		{
+			name:          "neutron label not equal",
+			labelSelector: "neutron:router_name!=public",
+			expected:      []string{"router-2", "router-3"},
+		},
+		{
+			name:          "label in set",
+			labelSelector: "neutron:router_name in (public, private)",
+			expected:      []string{"router-1", "router-2"},
+		},
+		{
+			name:          "label not in set",
+			labelSelector: "neutron:router_name notin (public)",
+			expected:      []string{"router-2", "router-3"},
+		},
+		{
+			name:          "label exists",
+			labelSelector: "neutron:availability_zone_hints",
+			expected:      []string{"router-1"},
+		},
+		{
+			name:          "label does not exist",
+			labelSelector: "!neutron:availability_zone_hints",
+			expected:      []string{"router-2", "router-3"},
+		},
+		{
+			name:          "missing label with empty value",
+			labelSelector: "neutron:availability_zone_hints=",
+			expected:      []string{},
+		},
+		{
+			name:          "element of a label with a comma",
+			labelSelector: "neutron:availability_zone_hints=az-2",
+			expected:      []string{"router-1"},
+		},
+		{
+			name:          "label with a comma in set",
+			labelSelector: "neutron:availability_zone_hints in (az-2,az-3)",
+			expected:      []string{"router-1"},
+		},
+		{
+			name:          "label with a comma not in set",
+			labelSelector: "neutron:availability_zone_hints notin (az-3)",
+			expected:      []string{"router-1", "router-2", "router-3"},
+		},
+		{
+			name:          "label value with a colon",
+			labelSelector: "neutron:router_name!=network:public",
+			expected:      []string{"router-1", "router-2", "router-3"},
+		},
+		{
+			name:          "multiple labels",
+			labelSelector: "neutron:router_name=public,neutron:availability_zone_hints=az-3",
+			expected:      []string{},
+		},
+		{
+			name:          "field",
+			fieldSelector: "status.agent=gwc-1",
+			expected:      []string{"router-1"},
+		},
+		{
+			name:          "field with leading dot",
+			fieldSelector: ".status.agent=gwc-2",
+			expected:      []string{"router-3"},
+		},
+		{
+			name:          "field not equal",
+			fieldSelector: "status.agent!=gwc-1",
+			expected:      []string{"router-2", "router-3"},
+		},
+		{
+			name:          "missing field with empty value",
+			fieldSelector: "status.agent=",
+			expected:      []string{"router-2"},
+		},
+		{
+			name:          "unknown field with empty value",
+			fieldSelector: "status.unknown=",
+			expected:      []string{"router-1", "router-2", "router-3"},
+		},
+		{
+			name:          "boolean field",
+			fieldSelector: "status.enabled=false",
+			expected:      []string{"router-2"},
+		},
+		{
+			name:          "number field",
+			fieldSelector: "status.nats=2",
+			expected:      []string{"router-1"},
+		},
+		{
+			name:          "any element of a list field",
+			fieldSelector: "status.externalIPs=203.0.113.11",
+			expected:      []string{"router-1"},
+		},
+		{
+			name:          "no element of a list field",
+			fieldSelector: "status.externalIPs!=203.0.113.11",
+			expected:      []string{"router-2", "router-3"},
+		},
+		{
+			name:          "empty list field",
+			fieldSelector: "status.externalIPs=",
+			expected:      []string{"router-3"},
+		},
+		{
+			name:          "labels and fields",
+			labelSelector: "neutron:router_name!=private",
+			fieldSelector: "status.enabled=true,status.agent!=gwc-1",
+			expected:      []string{"router-3"},
+		},
+		{
+			name:          "invalid label selector",
+			labelSelector: "neutron:router_name in public",
+			err:           "invalid label selector",
+		},
+		{
+			name:          "invalid field selector",
+			fieldSelector: "status.agent>gwc-1",
+			err:           "invalid field selector",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, err := NewSelector(tt.labelSelector, tt.fieldSelector)
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			list := testRouters()
+			require.NoError(t, selector.Filter(list))
+
+			names := []string{}
+			for _, router := range list.Items {
+				names = append(names, router.Name)
+			}
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
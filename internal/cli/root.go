@@ -27,7 +27,11 @@ func NewRootCommand() *cobra.Command {
 	configFlags.AddFlags(rootCmd.PersistentFlags())
 
 	rootCmd.AddCommand(NewGetCommand(configFlags))
+	rootCmd.AddCommand(NewDescribeCommand(configFlags))
 	rootCmd.AddCommand(NewFailoverCommand(configFlags))
+	rootCmd.AddCommand(NewRebalanceCommand(configFlags))
+	rootCmd.AddCommand(NewEventsCommand(configFlags))
+	rootCmd.AddCommand(NewOVNCommand(configFlags))
 	rootCmd.AddCommand(newOVNNbctlCmd(configFlags))
 	rootCmd.AddCommand(newOVNSbctlCmd(configFlags))
 
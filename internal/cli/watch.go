@@ -0,0 +1,280 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ovn-org/libovsdb/cache"
+	"github.com/ovn-org/libovsdb/model"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/cli-runtime/pkg/printers"
+	"sigs.k8s.io/yaml"
+
+	"github.com/vexxhost/atmosphere/internal/cli/resources"
+)
+
+// watchSettleInterval is the time to wait after a change of the OVN databases
+// before listing the resources again, so that the rows changed by a single
+// transaction, or a burst of them, result in a single update
+const watchSettleInterval = 200 * time.Millisecond
+
+// watchEvent is a change of a resource printed while watching
+type watchEvent struct {
+	Type   watch.EventType `json:"type"`
+	Object runtime.Object  `json:"object"`
+}
+
+// watchResource prints the resources and then prints them again every time
+// they change in the OVN databases until the context is done
+func (g *GetCmd) watchResource(ctx context.Context, resource resources.Resource, clients *resources.Clients, names []string, selector *resources.Selector, data runtime.Object, out io.Writer) error {
+	// Get notified of any change in the cache of the monitored tables, the
+	// channel is buffered so that changes are coalesced while listing
+	changes := make(chan struct{}, 1)
+	notify := func() {
+		select {
+		case changes <- struct{}{}:
+		default:
+		}
+	}
+	handler := &cache.EventHandlerFuncs{
+		AddFunc:    func(string, model.Model) { notify() },
+		UpdateFunc: func(string, model.Model, model.Model) { notify() },
+		DeleteFunc: func(string, model.Model) { notify() },
+	}
+
+	clients.NB.Cache().AddEventHandler(handler)
+	if clients.SB != nil {
+		clients.SB.Cache().AddEventHandler(handler)
+	}
+
+	previous, err := watchItems(data)
+	if err != nil {
+		return err
+	}
+
+	// Print the existing resources as initial ADDED events
+	events := make([]watchEvent, 0, len(previous.keys))
+	for _, key := range previous.keys {
+		events = append(events, watchEvent{Type: watch.Added, Object: previous.items[key]})
+	}
+
+	// NOTE: Like kubectl, the headers are only printed once, with the first
+	//       resources printed.
+	printHeaders := !g.noHeaders
+	if err := g.printWatchEvents(resource, data, events, printHeaders, out); err != nil {
+		return err
+	}
+	if len(events) > 0 {
+		printHeaders = false
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changes:
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(watchSettleInterval):
+		}
+
+		// Drop the changes made while waiting, they are part of this update
+		select {
+		case <-changes:
+		default:
+		}
+
+		data, err := g.list(ctx, resource, clients, names, selector)
+		if err != nil {
+			return err
+		}
+
+		current, err := watchItems(data)
+		if err != nil {
+			return err
+		}
+
+		events := diffWatchItems(previous, current)
+		previous = current
+
+		if len(events) == 0 {
+			continue
+		}
+
+		if err := g.printWatchEvents(resource, data, events, printHeaders, out); err != nil {
+			return err
+		}
+		printHeaders = false
+	}
+}
+
+// printWatchEvents prints the resources changed by the events, using data as
+// a template for the list holding the changed resources
+func (g *GetCmd) printWatchEvents(resource resources.Resource, data runtime.Object, events []watchEvent, printHeaders bool, out io.Writer) error {
+	if !g.isTableOutput() {
+		// NOTE: Events are only printed as JSON or YAML, the other formats
+		//       print the changed resources like kubectl does.
+		format := g.outputFormat()
+		if g.watchEvents && (format == "json" || format == "yaml") {
+			for _, event := range events {
+				if err := printWatchObject(event, out, format); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}
+
+		printer, err := g.printFlags.ToPrinter()
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			if err := printer.PrintObj(event.Object, out); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	var table *metav1.Table
+	for _, event := range events {
+		// Render the table of a list holding only the changed resource, so
+		// that resources rendered as multiple rows are handled
+		list := data.DeepCopyObject()
+		if err := meta.SetList(list, []runtime.Object{event.Object}); err != nil {
+			return fmt.Errorf("failed to build list of %s: %w", resource.Name(), err)
+		}
+
+		eventTable, err := g.getTable(resource, list)
+		if err != nil {
+			return err
+		}
+
+		if g.watchEvents {
+			eventTable.ColumnDefinitions = append([]metav1.TableColumnDefinition{
+				{Name: "EVENT", Type: "string", Description: "Type of the change"},
+			}, eventTable.ColumnDefinitions...)
+
+			for i := range eventTable.Rows {
+				eventTable.Rows[i].Cells = append([]interface{}{string(event.Type)}, eventTable.Rows[i].Cells...)
+			}
+		}
+
+		if table == nil {
+			table = eventTable
+		} else {
+			table.Rows = append(table.Rows, eventTable.Rows...)
+		}
+	}
+
+	if table == nil {
+		return nil
+	}
+
+	printer := printers.NewTablePrinter(printers.PrintOptions{
+		NoHeaders: !printHeaders,
+	})
+
+	return printer.PrintObj(table, out)
+}
+
+// watchItemSet holds the resources of a list by their UID, or by their name
+// for resources without UID, preserving the order of the list
+type watchItemSet struct {
+	keys  []string
+	items map[string]runtime.Object
+}
+
+// watchItems builds the set of resources of a list
+func watchItems(list runtime.Object) (*watchItemSet, error) {
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract resources from list: %w", err)
+	}
+
+	set := &watchItemSet{
+		keys:  make([]string, 0, len(items)),
+		items: make(map[string]runtime.Object, len(items)),
+	}
+
+	for _, item := range items {
+		accessor, err := meta.Accessor(item)
+		if err != nil {
+			return nil, fmt.Errorf("failed to access metadata of resource: %w", err)
+		}
+
+		key := string(accessor.GetUID())
+		if key == "" {
+			key = accessor.GetName()
+		}
+
+		set.keys = append(set.keys, key)
+		set.items[key] = item
+	}
+
+	return set, nil
+}
+
+// diffWatchItems returns the events turning the previous resources into the
+// current ones, in the order of the current list followed by the deletions
+func diffWatchItems(previous, current *watchItemSet) []watchEvent {
+	var events []watchEvent
+
+	for _, key := range current.keys {
+		item := current.items[key]
+
+		old, ok := previous.items[key]
+		switch {
+		case !ok:
+			events = append(events, watchEvent{Type: watch.Added, Object: item})
+		case !equality.Semantic.DeepEqual(old, item):
+			events = append(events, watchEvent{Type: watch.Modified, Object: item})
+		}
+	}
+
+	for _, key := range previous.keys {
+		if _, ok := current.items[key]; !ok {
+			events = append(events, watchEvent{Type: watch.Deleted, Object: previous.items[key]})
+		}
+	}
+
+	return events
+}
+
+// printWatchObject prints an event in JSON or YAML format, YAML documents are
+// separated like kubectl does when watching
+func printWatchObject(event watchEvent, out io.Writer, format string) error {
+	switch format {
+	case "json":
+		data, err := json.MarshalIndent(event, "", "    ")
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(out, "%s\n", data)
+		return err
+	case "yaml":
+		data, err := yaml.Marshal(event)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(out, "---\n%s", data)
+		return err
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+}
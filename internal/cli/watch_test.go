@@ -0,0 +1,141 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+	"github.com/vexxhost/atmosphere/internal/cli/resources"
+)
+
+// testWatchItems returns the set of resources of a list of routers
+func testWatchItems(t *testing.T, list *apiv1alpha1.RouterList) *watchItemSet {
+	t.Helper()
+
+	items, err := watchItems(list)
+	require.NoError(t, err)
+
+	return items
+}
+
+func TestDiffWatchItems(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous *apiv1alpha1.RouterList
+		current  *apiv1alpha1.RouterList
+		expected []string
+	}{
+		{
+			name:     "unchanged",
+			previous: testRouterList([]string{"net-1", "net-2"}, []int{1, 2}),
+			current:  testRouterList([]string{"net-1", "net-2"}, []int{1, 2}),
+		},
+		{
+			name:     "added",
+			previous: testRouterList([]string{"net-1"}, []int{1}),
+			current:  testRouterList([]string{"net-1", "net-2"}, []int{1, 2}),
+			expected: []string{"ADDED b net-2"},
+		},
+		{
+			name:     "removed",
+			previous: testRouterList([]string{"net-1", "net-2"}, []int{1, 2}),
+			current:  testRouterList([]string{"net-1"}, []int{1}),
+			expected: []string{"DELETED b net-2"},
+		},
+		{
+			name:     "agent changed",
+			previous: testRouterList([]string{"net-1", "net-2"}, []int{1, 2}),
+			current:  testRouterList([]string{"net-1", "net-3"}, []int{1, 2}),
+			expected: []string{"MODIFIED b net-3"},
+		},
+		{
+			name:     "deletions after changes",
+			previous: testRouterList([]string{"net-1", "net-2", "net-3"}, []int{1, 2, 3}),
+			current:  testRouterList([]string{"", "net-2"}, []int{1, 2}),
+			expected: []string{"MODIFIED a ", "DELETED c net-3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events := diffWatchItems(testWatchItems(t, tt.previous), testWatchItems(t, tt.current))
+
+			var result []string
+			for _, event := range events {
+				router := event.Object.(*apiv1alpha1.Router)
+				result = append(result, string(event.Type)+" "+router.Name+" "+router.Status.Agent)
+			}
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestPrintWatchEvents(t *testing.T) {
+	list := testRouterList([]string{"net-1", "net-2"}, []int{1, 2})
+	events := []watchEvent{
+		{Type: watch.Modified, Object: &list.Items[0]},
+		{Type: watch.Deleted, Object: &list.Items[1]},
+	}
+
+	tests := []struct {
+		name         string
+		output       string
+		watchEvents  bool
+		printHeaders bool
+		expected     string
+	}{
+		{
+			name:         "table with headers",
+			output:       "custom-columns=NAME:.metadata.name,AGENT:.status.agent",
+			printHeaders: true,
+			expected:     "NAME   AGENT\na      net-1\nb      net-2\n",
+		},
+		{
+			name:     "table without headers",
+			output:   "custom-columns=NAME:.metadata.name,AGENT:.status.agent",
+			expected: "a     net-1\nb     net-2\n",
+		},
+		{
+			name:         "table with events",
+			output:       "custom-columns=NAME:.metadata.name",
+			watchEvents:  true,
+			printHeaders: true,
+			expected:     "EVENT      NAME\nMODIFIED   a\nDELETED    b\n",
+		},
+		{
+			name:        "yaml events",
+			output:      "yaml",
+			watchEvents: true,
+			expected: "---\nobject:\n  metadata:\n    creationTimestamp: null\n    name: a\n    uid: a\n  status:\n    agent: net-1\n    enabled: false\n    nats: 1\n    staticRoutes: 0\ntype: MODIFIED\n" +
+				"---\nobject:\n  metadata:\n    creationTimestamp: null\n    name: b\n    uid: b\n  status:\n    agent: net-2\n    enabled: false\n    nats: 2\n    staticRoutes: 0\ntype: DELETED\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GetCmd{
+				printFlags:  genericclioptions.NewPrintFlags(""),
+				watchEvents: tt.watchEvents,
+			}
+			*g.printFlags.OutputFormat = tt.output
+
+			var out bytes.Buffer
+			require.NoError(t, g.printWatchEvents(&resources.RouterResource{}, list, events, tt.printHeaders, &out))
+			assert.Equal(t, tt.expected, out.String())
+		})
+	}
+}
+
+func TestPrintWatchEvents_NoEvents(t *testing.T) {
+	g := &GetCmd{printFlags: genericclioptions.NewPrintFlags("")}
+	*g.printFlags.OutputFormat = "custom-columns=NAME:.metadata.name"
+
+	var out bytes.Buffer
+	require.NoError(t, g.printWatchEvents(&resources.RouterResource{}, testRouterList(nil, nil), nil, true, &out))
+	assert.Empty(t, out.String())
+}
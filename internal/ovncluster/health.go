@@ -0,0 +1,144 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovncluster
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ProblemType is the type of a problem of the cluster
+type ProblemType string
+
+const (
+	// ProblemSplitBrain is reported when members disagree on the cluster or
+	// its leader
+	ProblemSplitBrain ProblemType = "SplitBrain"
+
+	// ProblemNoLeader is reported when no member is the leader
+	ProblemNoLeader ProblemType = "NoLeader"
+
+	// ProblemMissingMember is reported when a pod is not a member of the
+	// cluster, or a server of the cluster is not running in any pod
+	ProblemMissingMember ProblemType = "MissingMember"
+
+	// ProblemLaggingFollower is reported when the log of a follower is too
+	// far behind the log of the leader
+	ProblemLaggingFollower ProblemType = "LaggingFollower"
+)
+
+// Member is a member of the cluster running in a pod
+type Member struct {
+	// Pod is the name of the pod running the member
+	Pod string `json:"pod"`
+
+	// Status is the status reported by the member, it is nil if it could not
+	// be retrieved
+	Status *Status `json:"status,omitempty"`
+
+	// Error is the reason the status could not be retrieved
+	Error string `json:"error,omitempty"`
+}
+
+// Problem is a problem of the cluster
+type Problem struct {
+	// Type is the type of the problem
+	Type ProblemType `json:"type"`
+
+	// Message is a human readable description of the problem
+	Message string `json:"message"`
+}
+
+// Check returns the problems of the cluster formed by the members, followers
+// whose log is more than maxLag entries behind the log of the leader are
+// reported as lagging
+func Check(members []Member, maxLag uint64) []Problem {
+	problems := []Problem{}
+	report := func(problemType ProblemType, format string, args ...interface{}) {
+		problems = append(problems, Problem{Type: problemType, Message: fmt.Sprintf(format, args...)})
+	}
+
+	var reachable []Member
+	var leaders []Member
+	var clusterIDs []string
+
+	for _, member := range members {
+		switch {
+		case member.Status == nil:
+			report(ProblemMissingMember, "pod %s did not report its status: %s", member.Pod, member.Error)
+			continue
+		case member.Status.Status != memberStatus:
+			report(ProblemMissingMember, "pod %s is not a cluster member: %s", member.Pod, member.Status.Status)
+		}
+
+		reachable = append(reachable, member)
+
+		if member.Status.Role == RoleLeader {
+			leaders = append(leaders, member)
+		}
+
+		if !slices.Contains(clusterIDs, member.Status.ClusterID) {
+			clusterIDs = append(clusterIDs, member.Status.ClusterID)
+		}
+	}
+
+	if len(clusterIDs) > 1 {
+		report(ProblemSplitBrain, "members report different cluster IDs: %s", strings.Join(clusterIDs, ", "))
+	}
+
+	switch len(leaders) {
+	case 0:
+		if len(reachable) > 0 {
+			report(ProblemNoLeader, "no member is the leader")
+		}
+		return problems
+	case 1:
+	default:
+		descriptions := make([]string, 0, len(leaders))
+		for _, leader := range leaders {
+			descriptions = append(descriptions, fmt.Sprintf("%s (term %d)", leader.Pod, leader.Status.Term))
+		}
+		report(ProblemSplitBrain, "multiple members are the leader: %s", strings.Join(descriptions, ", "))
+		return problems
+	}
+
+	leader := leaders[0].Status
+	leaderID := ShortID(leader.ServerID)
+
+	// The servers of the cluster as seen by the leader must all be running in
+	// a pod, and every pod must be one of them
+	for _, server := range leader.Servers {
+		if !slices.ContainsFunc(reachable, func(member Member) bool {
+			return ShortID(member.Status.ServerID) == server.ID
+		}) {
+			report(ProblemMissingMember, "server %s at %s is in the cluster but not running in any reachable pod", server.ID, server.Address)
+		}
+	}
+
+	for _, member := range reachable {
+		status := member.Status
+		serverID := ShortID(status.ServerID)
+
+		if member.Status.Status == memberStatus && !slices.ContainsFunc(leader.Servers, func(server Server) bool {
+			return server.ID == serverID
+		}) {
+			report(ProblemMissingMember, "pod %s (server %s) is not one of the servers of the leader", member.Pod, serverID)
+		}
+
+		if status.Role == RoleLeader {
+			continue
+		}
+
+		if status.Leader != leaderID && status.Leader != "unknown" {
+			report(ProblemSplitBrain, "pod %s follows %s instead of the leader %s", member.Pod, status.Leader, leaderID)
+		}
+
+		if leader.LogIndex > status.LogIndex && leader.LogIndex-status.LogIndex > maxLag {
+			report(ProblemLaggingFollower, "pod %s is %d log entries behind the leader", member.Pod, leader.LogIndex-status.LogIndex)
+		}
+	}
+
+	return problems
+}
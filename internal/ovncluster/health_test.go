@@ -0,0 +1,128 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovncluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testMembers returns a healthy cluster of a leader and a follower
+func testMembers(t *testing.T) []Member {
+	t.Helper()
+
+	leader, err := ParseStatus(testLeaderOutput)
+	require.NoError(t, err)
+
+	follower, err := ParseStatus(testFollowerOutput)
+	require.NoError(t, err)
+
+	return []Member{
+		{Pod: "ovn-ovsdb-nb-0", Status: leader},
+		{Pod: "ovn-ovsdb-nb-1", Status: follower},
+	}
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name     string
+		mutate   func(members []Member) []Member
+		maxLag   uint64
+		expected []Problem
+	}{
+		{
+			name:     "healthy",
+			maxLag:   10,
+			expected: []Problem{},
+		},
+		{
+			name:   "lagging follower",
+			maxLag: 1,
+			expected: []Problem{
+				{Type: ProblemLaggingFollower, Message: "pod ovn-ovsdb-nb-1 is 2 log entries behind the leader"},
+			},
+		},
+		{
+			name:   "unreachable pod",
+			maxLag: 10,
+			mutate: func(members []Member) []Member {
+				members[1] = Member{Pod: "ovn-ovsdb-nb-1", Error: "pod is not running"}
+				return members
+			},
+			expected: []Problem{
+				{Type: ProblemMissingMember, Message: "pod ovn-ovsdb-nb-1 did not report its status: pod is not running"},
+				{Type: ProblemMissingMember, Message: "server a3b1 at tcp:10.0.0.2:6643 is in the cluster but not running in any reachable pod"},
+			},
+		},
+		{
+			name:   "pod not in the cluster",
+			maxLag: 10,
+			mutate: func(members []Member) []Member {
+				members[0].Status.Servers = members[0].Status.Servers[:1]
+				return members
+			},
+			expected: []Problem{
+				{Type: ProblemMissingMember, Message: "pod ovn-ovsdb-nb-1 (server a3b1) is not one of the servers of the leader"},
+			},
+		},
+		{
+			name:   "multiple leaders",
+			maxLag: 10,
+			mutate: func(members []Member) []Member {
+				members[1].Status.Role = RoleLeader
+				members[1].Status.Term = 5
+				return members
+			},
+			expected: []Problem{
+				{Type: ProblemSplitBrain, Message: "multiple members are the leader: ovn-ovsdb-nb-0 (term 4), ovn-ovsdb-nb-1 (term 5)"},
+			},
+		},
+		{
+			name:   "follower of another leader",
+			maxLag: 10,
+			mutate: func(members []Member) []Member {
+				members[1].Status.Leader = "c4d2"
+				return members
+			},
+			expected: []Problem{
+				{Type: ProblemSplitBrain, Message: "pod ovn-ovsdb-nb-1 follows c4d2 instead of the leader ed29"},
+			},
+		},
+		{
+			name:   "different clusters",
+			maxLag: 10,
+			mutate: func(members []Member) []Member {
+				members[1].Status.ClusterID = "9f8e7d6c-0000-4000-8000-000000000001"
+				return members
+			},
+			expected: []Problem{
+				{Type: ProblemSplitBrain, Message: "members report different cluster IDs: " + testClusterID + ", 9f8e7d6c-0000-4000-8000-000000000001"},
+			},
+		},
+		{
+			name:   "no leader",
+			maxLag: 10,
+			mutate: func(members []Member) []Member {
+				members[0].Status.Role = RoleCandidate
+				return members
+			},
+			expected: []Problem{
+				{Type: ProblemNoLeader, Message: "no member is the leader"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			members := testMembers(t)
+			if tt.mutate != nil {
+				members = tt.mutate(members)
+			}
+
+			assert.Equal(t, tt.expected, Check(members, tt.maxLag))
+		})
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovncluster
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrQuorum is returned when removing a server would leave the cluster
+// without a majority of healthy servers
+var ErrQuorum = errors.New("cluster would drop below quorum")
+
+// Quorum returns the number of servers forming a majority of a cluster of the
+// given size
+func Quorum(size int) int {
+	return size/2 + 1
+}
+
+// Leader returns the member which is the leader, or an error if the members
+// do not agree on a single leader
+func Leader(members []Member) (*Member, error) {
+	for _, problem := range Check(members, ^uint64(0)) {
+		if problem.Type == ProblemSplitBrain || problem.Type == ProblemNoLeader {
+			return nil, fmt.Errorf("cluster has no single leader: %s", problem.Message)
+		}
+	}
+
+	for i := range members {
+		if members[i].Status != nil && members[i].Status.Role == RoleLeader {
+			return &members[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("cluster has no single leader: no member reported its status")
+}
+
+// FindServer returns the ID of the server of the cluster running in the pod
+// with the given name, or matching the given full or short server ID
+func FindServer(members []Member, reference string) (string, error) {
+	leader, err := Leader(members)
+	if err != nil {
+		return "", err
+	}
+
+	for _, member := range members {
+		if member.Pod != reference {
+			continue
+		}
+
+		if member.Status == nil {
+			return "", fmt.Errorf("pod %s did not report its status: %s", member.Pod, member.Error)
+		}
+
+		return ShortID(member.Status.ServerID), nil
+	}
+
+	var matches []string
+	for _, server := range leader.Status.Servers {
+		if strings.HasPrefix(reference, server.ID) || strings.HasPrefix(server.ID, reference) {
+			matches = append(matches, server.ID)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no pod or server %q found in the cluster", reference)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("server %q is ambiguous, it matches: %s", reference, strings.Join(matches, ", "))
+	}
+}
+
+// CheckRemoval returns an error if removing the server with the given short
+// ID from the cluster, by leaving or being kicked, would leave the cluster
+// without a majority of healthy servers. Healthy servers are the members
+// which follow the leader and are in its configuration.
+func CheckRemoval(members []Member, serverID string) error {
+	leader, err := Leader(members)
+	if err != nil {
+		return err
+	}
+
+	leaderID := ShortID(leader.Status.ServerID)
+
+	// NOTE: The cluster must keep a majority of healthy servers once it no
+	//       longer includes the removed server, so both are counted among
+	//       the servers of the configuration after the removal.
+	remaining := make(map[string]bool, len(leader.Status.Servers))
+	for _, server := range leader.Status.Servers {
+		if server.ID != serverID {
+			remaining[server.ID] = true
+		}
+	}
+
+	healthy := 0
+	for _, member := range members {
+		status := member.Status
+		if status == nil || status.Status != memberStatus {
+			continue
+		}
+
+		if status.Role != RoleLeader && status.Leader != leaderID {
+			continue
+		}
+
+		if remaining[ShortID(status.ServerID)] {
+			healthy++
+		}
+	}
+
+	if required := Quorum(len(remaining)); healthy < required {
+		return fmt.Errorf("%w: removing server %s leaves a cluster of %d servers with %d healthy servers, %d are required", ErrQuorum, serverID, len(remaining), healthy, required)
+	}
+
+	return nil
+}
+
+// CheckLeadershipTarget returns an error unless the pod runs the only follower
+// of the leader with the most up to date log, which is the follower the leader
+// hands the leadership over to when it leaves the cluster
+func CheckLeadershipTarget(members []Member, pod string) error {
+	leader, err := Leader(members)
+	if err != nil {
+		return err
+	}
+
+	if leader.Pod == pod {
+		return fmt.Errorf("pod %s is already the leader", pod)
+	}
+
+	leaderID := ShortID(leader.Status.ServerID)
+	isFollower := func(member *Member) bool {
+		status := member.Status
+		return status != nil && status.Status == memberStatus && status.Role == RoleFollower && status.Leader == leaderID
+	}
+
+	var target *Member
+	for i := range members {
+		if members[i].Pod == pod {
+			target = &members[i]
+		}
+	}
+
+	switch {
+	case target == nil:
+		return fmt.Errorf("no pod %s found in the cluster", pod)
+	case !isFollower(target):
+		return fmt.Errorf("pod %s is not a follower of the leader %s", pod, leaderID)
+	}
+
+	for i := range members {
+		member := &members[i]
+		if member == target || !isFollower(member) {
+			continue
+		}
+
+		switch {
+		case member.Status.LogIndex > target.Status.LogIndex:
+			return fmt.Errorf("pod %s is not the most up to date follower, pod %s is %d log entries ahead of it", pod, member.Pod, member.Status.LogIndex-target.Status.LogIndex)
+		case member.Status.LogIndex == target.Status.LogIndex:
+			return fmt.Errorf("pod %s is as up to date as pod %s, the leadership could be handed over to either of them", pod, member.Pod)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,231 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovncluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withStaleServer adds a server which is not running in any pod to the
+// configuration of the leader
+func withStaleServer(members []Member) []Member {
+	members[0].Status.Servers = append(members[0].Status.Servers, Server{ID: "c4d2", Address: "tcp:10.0.0.3:6643"})
+	return members
+}
+
+func TestQuorum(t *testing.T) {
+	for size, expected := range map[int]int{1: 1, 2: 2, 3: 2, 4: 3, 5: 3} {
+		assert.Equal(t, expected, Quorum(size), "size %d", size)
+	}
+}
+
+func TestLeader(t *testing.T) {
+	members := testMembers(t)
+
+	leader, err := Leader(members)
+	require.NoError(t, err)
+	assert.Equal(t, "ovn-ovsdb-nb-0", leader.Pod)
+
+	members[1].Status.Role = RoleLeader
+	_, err = Leader(members)
+	assert.ErrorContains(t, err, "cluster has no single leader: multiple members are the leader")
+}
+
+func TestFindServer(t *testing.T) {
+	tests := []struct {
+		name      string
+		reference string
+		expected  string
+		err       string
+	}{
+		{
+			name:      "pod",
+			reference: "ovn-ovsdb-nb-1",
+			expected:  "a3b1",
+		},
+		{
+			name:      "short server id",
+			reference: "c4d2",
+			expected:  "c4d2",
+		},
+		{
+			name:      "full server id",
+			reference: testServerID2,
+			expected:  "a3b1",
+		},
+		{
+			name:      "unknown",
+			reference: "ovn-ovsdb-nb-2",
+			err:       `no pod or server "ovn-ovsdb-nb-2" found in the cluster`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serverID, err := FindServer(withStaleServer(testMembers(t)), tt.reference)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, serverID)
+		})
+	}
+}
+
+func TestCheckRemoval(t *testing.T) {
+	tests := []struct {
+		name     string
+		members  func(t *testing.T) []Member
+		serverID string
+		err      string
+	}{
+		{
+			name:     "follower of a healthy cluster",
+			members:  testMembers,
+			serverID: "a3b1",
+		},
+		{
+			name:     "leader of a healthy cluster",
+			members:  testMembers,
+			serverID: "ed29",
+		},
+		{
+			name: "stale server",
+			members: func(t *testing.T) []Member {
+				return withStaleServer(testMembers(t))
+			},
+			serverID: "c4d2",
+		},
+		{
+			name: "below quorum",
+			members: func(t *testing.T) []Member {
+				return withStaleServer(testMembers(t))
+			},
+			serverID: "a3b1",
+			err:      "cluster would drop below quorum: removing server a3b1 leaves a cluster of 2 servers with 1 healthy servers, 2 are required",
+		},
+		{
+			name: "leader leaving below quorum",
+			members: func(t *testing.T) []Member {
+				return withStaleServer(testMembers(t))
+			},
+			serverID: "ed29",
+			err:      "cluster would drop below quorum: removing server ed29 leaves a cluster of 2 servers with 1 healthy servers, 2 are required",
+		},
+		{
+			name: "follower of another leader",
+			members: func(t *testing.T) []Member {
+				members := testMembers(t)
+				members[1].Status.Leader = "c4d2"
+				return members
+			},
+			serverID: "ed29",
+			err:      "cluster has no single leader: pod ovn-ovsdb-nb-1 follows c4d2 instead of the leader ed29",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckRemoval(tt.members(t), tt.serverID)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+// withFollower adds a pod running another follower of the leader, whose log
+// has the given index
+func withFollower(members []Member, pod, serverID string, logIndex uint64) []Member {
+	status := *members[1].Status
+	status.ServerID = serverID
+	status.LogIndex = logIndex
+
+	return append(members, Member{Pod: pod, Status: &status})
+}
+
+func TestCheckLeadershipTarget(t *testing.T) {
+	tests := []struct {
+		name    string
+		members func(t *testing.T) []Member
+		pod     string
+		err     string
+	}{
+		{
+			name:    "only follower",
+			members: testMembers,
+			pod:     "ovn-ovsdb-nb-1",
+		},
+		{
+			name: "most up to date follower",
+			members: func(t *testing.T) []Member {
+				return withFollower(testMembers(t), "ovn-ovsdb-nb-2", "c4d2", 140)
+			},
+			pod: "ovn-ovsdb-nb-1",
+		},
+		{
+			name: "lagging follower",
+			members: func(t *testing.T) []Member {
+				return withFollower(testMembers(t), "ovn-ovsdb-nb-2", "c4d2", 149)
+			},
+			pod: "ovn-ovsdb-nb-1",
+			err: "pod ovn-ovsdb-nb-1 is not the most up to date follower, pod ovn-ovsdb-nb-2 is 1 log entries ahead of it",
+		},
+		{
+			name: "equally up to date followers",
+			members: func(t *testing.T) []Member {
+				return withFollower(testMembers(t), "ovn-ovsdb-nb-2", "c4d2", 148)
+			},
+			pod: "ovn-ovsdb-nb-1",
+			err: "pod ovn-ovsdb-nb-1 is as up to date as pod ovn-ovsdb-nb-2, the leadership could be handed over to either of them",
+		},
+		{
+			name: "unreachable follower is ignored",
+			members: func(t *testing.T) []Member {
+				return append(testMembers(t), Member{Pod: "ovn-ovsdb-nb-2", Error: "pod is not running"})
+			},
+			pod: "ovn-ovsdb-nb-1",
+		},
+		{
+			name:    "leader",
+			members: testMembers,
+			pod:     "ovn-ovsdb-nb-0",
+			err:     "pod ovn-ovsdb-nb-0 is already the leader",
+		},
+		{
+			name: "unreachable target",
+			members: func(t *testing.T) []Member {
+				return append(testMembers(t), Member{Pod: "ovn-ovsdb-nb-2", Error: "pod is not running"})
+			},
+			pod: "ovn-ovsdb-nb-2",
+			err: "pod ovn-ovsdb-nb-2 is not a follower of the leader ed29",
+		},
+		{
+			name:    "unknown pod",
+			members: testMembers,
+			pod:     "ovn-ovsdb-nb-3",
+			err:     "no pod ovn-ovsdb-nb-3 found in the cluster",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckLeadershipTarget(tt.members(t), tt.pod)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
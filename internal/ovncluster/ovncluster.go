@@ -0,0 +1,228 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovncluster
+
+import (
+	"bufio"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Role is the RAFT role of a server
+type Role string
+
+const (
+	// RoleLeader is the role of the server replicating the log
+	RoleLeader Role = "leader"
+
+	// RoleFollower is the role of the servers following the leader
+	RoleFollower Role = "follower"
+
+	// RoleCandidate is the role of a server during an election
+	RoleCandidate Role = "candidate"
+)
+
+// memberStatus is the status of a server which joined the cluster
+const memberStatus = "cluster member"
+
+// Server is a server of the cluster as seen by a member
+type Server struct {
+	// ID is the server ID
+	ID string `json:"id"`
+
+	// Address is the address of the server for the RAFT protocol
+	Address string `json:"address"`
+
+	// Self is true for the member reporting the status
+	Self bool `json:"self,omitempty"`
+
+	// MatchIndex is the highest log index known to be replicated on the
+	// server, it is only reported by the leader
+	MatchIndex *uint64 `json:"matchIndex,omitempty"`
+}
+
+// Status is the status of a member of a clustered database, as reported by
+// `ovn-appctl cluster/status`
+type Status struct {
+	// Database is the name of the database, such as OVN_Northbound
+	Database string `json:"database"`
+
+	// ClusterID is the cluster ID
+	ClusterID string `json:"clusterID"`
+
+	// ServerID is the server ID of the member
+	ServerID string `json:"serverID"`
+
+	// Address is the address of the member for the RAFT protocol
+	Address string `json:"address"`
+
+	// Status is the membership status, such as `cluster member`
+	Status string `json:"status"`
+
+	// Role is the RAFT role of the member
+	Role Role `json:"role"`
+
+	// Term is the current RAFT term
+	Term uint64 `json:"term"`
+
+	// Leader is the server ID of the leader, `self` on the leader itself or
+	// `unknown` during an election
+	Leader string `json:"leader"`
+
+	// ElectionTimer is the election timer in milliseconds
+	ElectionTimer uint64 `json:"electionTimer"`
+
+	// LogIndex is the index of the next entry appended to the log
+	LogIndex uint64 `json:"logIndex"`
+
+	// Connections are the connections to other servers, `->` for outgoing and
+	// `<-` for incoming ones
+	Connections []string `json:"connections"`
+
+	// Servers are the servers of the cluster as seen by the member
+	Servers []Server `json:"servers"`
+}
+
+var (
+	// idRegexp matches IDs printed as `<short id> (<uuid>)`
+	idRegexp = regexp.MustCompile(`^\S+ \(([0-9a-f-]+)\)$`)
+
+	// logRegexp matches the range of the log printed as `[<first>, <next>]`
+	logRegexp = regexp.MustCompile(`^\[(\d+), (\d+)\]$`)
+
+	// serverRegexp matches the servers printed as `<sid> (<sid> at <address>)`
+	// followed by `(self)` and the replication state on the leader
+	serverRegexp = regexp.MustCompile(`^(\S+) \(\S+ at ([^)]+)\)( \(self\))?(.*)$`)
+
+	// matchIndexRegexp matches the replication state of a server
+	matchIndexRegexp = regexp.MustCompile(`match_index=(\d+)`)
+)
+
+// ParseStatus parses the output of `ovn-appctl cluster/status`
+func ParseStatus(output string) (*Status, error) {
+	status := &Status{}
+	inServers := false
+
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		// NOTE: The servers are the indented lines following `Servers:`
+		if inServers {
+			if server := strings.TrimSpace(line); server != "" && strings.HasPrefix(line, " ") {
+				parsed, err := parseServer(server)
+				if err != nil {
+					return nil, err
+				}
+
+				status.Servers = append(status.Servers, *parsed)
+				continue
+			}
+
+			inServers = false
+		}
+
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+
+		var err error
+		switch key {
+		case "Name":
+			status.Database = value
+		case "Cluster ID":
+			status.ClusterID = parseID(value)
+		case "Server ID":
+			status.ServerID = parseID(value)
+		case "Address":
+			status.Address = value
+		case "Status":
+			status.Status = value
+		case "Role":
+			status.Role = Role(value)
+		case "Term":
+			status.Term, err = strconv.ParseUint(value, 10, 64)
+		case "Leader":
+			status.Leader = value
+		case "Election timer":
+			status.ElectionTimer, err = strconv.ParseUint(value, 10, 64)
+		case "Log":
+			status.LogIndex, err = parseLogIndex(value)
+		case "Connections":
+			status.Connections = strings.Fields(value)
+		case "Servers":
+			inServers = true
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %q: %w", line, err)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if status.ServerID == "" {
+		return nil, fmt.Errorf("no server ID found in cluster status")
+	}
+
+	return status, nil
+}
+
+// parseID returns the full ID from `<short id> (<uuid>)`, or the value itself
+func parseID(value string) string {
+	if match := idRegexp.FindStringSubmatch(value); match != nil {
+		return match[1]
+	}
+
+	return value
+}
+
+// parseLogIndex returns the index of the next entry from `[<first>, <next>]`
+func parseLogIndex(value string) (uint64, error) {
+	match := logRegexp.FindStringSubmatch(value)
+	if match == nil {
+		return 0, fmt.Errorf("unexpected log range %q", value)
+	}
+
+	return strconv.ParseUint(match[2], 10, 64)
+}
+
+// parseServer parses a server of the `Servers:` section
+func parseServer(line string) (*Server, error) {
+	match := serverRegexp.FindStringSubmatch(line)
+	if match == nil {
+		return nil, fmt.Errorf("unexpected server %q", line)
+	}
+
+	server := &Server{
+		ID:      match[1],
+		Address: match[2],
+		Self:    match[3] != "",
+	}
+
+	if index := matchIndexRegexp.FindStringSubmatch(match[4]); index != nil {
+		matchIndex, err := strconv.ParseUint(index[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse server %q: %w", line, err)
+		}
+
+		server.MatchIndex = &matchIndex
+	}
+
+	return server, nil
+}
+
+// ShortID returns the short form of a server or cluster ID printed by OVN
+func ShortID(id string) string {
+	if len(id) > 4 {
+		return id[:4]
+	}
+
+	return id
+}
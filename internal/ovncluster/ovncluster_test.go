@@ -0,0 +1,139 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovncluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	testClusterID = "1a2b3c4d-0000-4000-8000-000000000001"
+	testServerID1 = "ed29b8e7-0000-4000-8000-000000000001"
+	testServerID2 = "a3b1c2d3-0000-4000-8000-000000000002"
+)
+
+const testLeaderOutput = `ed29
+Name: OVN_Northbound
+Cluster ID: 1a2b (1a2b3c4d-0000-4000-8000-000000000001)
+Server ID: ed29 (ed29b8e7-0000-4000-8000-000000000001)
+Address: tcp:10.0.0.1:6643
+Status: cluster member
+Role: leader
+Term: 4
+Leader: self
+Vote: self
+
+Last Election started 12345 ms ago, reason: timeout
+Last Election won: 12340 ms ago
+Election timer: 1000
+Log: [2, 150]
+Entries not yet committed: 0
+Entries not yet applied: 0
+Connections: ->a3b1 <-a3b1
+Disconnections: 0
+Servers:
+    ed29 (ed29 at tcp:10.0.0.1:6643) (self) next_index=149 match_index=149
+    a3b1 (a3b1 at tcp:10.0.0.2:6643) next_index=150 match_index=149 last msg 100 ms ago
+`
+
+const testFollowerOutput = `a3b1
+Name: OVN_Northbound
+Cluster ID: 1a2b (1a2b3c4d-0000-4000-8000-000000000001)
+Server ID: a3b1 (a3b1c2d3-0000-4000-8000-000000000002)
+Address: tcp:10.0.0.2:6643
+Status: cluster member
+Role: follower
+Term: 4
+Leader: ed29
+Vote: ed29
+
+Election timer: 1000
+Log: [2, 148]
+Entries not yet committed: 0
+Entries not yet applied: 0
+Connections: ->ed29 <-ed29
+Disconnections: 0
+Servers:
+    ed29 (ed29 at tcp:10.0.0.1:6643)
+    a3b1 (a3b1 at tcp:10.0.0.2:6643) (self)
+`
+
+func TestParseStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		output   string
+		expected *Status
+		err      string
+	}{
+		{
+			name:   "leader",
+			output: testLeaderOutput,
+			expected: &Status{
+				Database:      "OVN_Northbound",
+				ClusterID:     testClusterID,
+				ServerID:      testServerID1,
+				Address:       "tcp:10.0.0.1:6643",
+				Status:        "cluster member",
+				Role:          RoleLeader,
+				Term:          4,
+				Leader:        "self",
+				ElectionTimer: 1000,
+				LogIndex:      150,
+				Connections:   []string{"->a3b1", "<-a3b1"},
+				Servers: []Server{
+					{ID: "ed29", Address: "tcp:10.0.0.1:6643", Self: true, MatchIndex: ptr.To[uint64](149)},
+					{ID: "a3b1", Address: "tcp:10.0.0.2:6643", MatchIndex: ptr.To[uint64](149)},
+				},
+			},
+		},
+		{
+			name:   "follower",
+			output: testFollowerOutput,
+			expected: &Status{
+				Database:      "OVN_Northbound",
+				ClusterID:     testClusterID,
+				ServerID:      testServerID2,
+				Address:       "tcp:10.0.0.2:6643",
+				Status:        "cluster member",
+				Role:          RoleFollower,
+				Term:          4,
+				Leader:        "ed29",
+				ElectionTimer: 1000,
+				LogIndex:      148,
+				Connections:   []string{"->ed29", "<-ed29"},
+				Servers: []Server{
+					{ID: "ed29", Address: "tcp:10.0.0.1:6643"},
+					{ID: "a3b1", Address: "tcp:10.0.0.2:6643", Self: true},
+				},
+			},
+		},
+		{
+			name:   "not clustered",
+			output: "ovsdb-server: database OVN_Northbound is not clustered\n",
+			err:    "no server ID found in cluster status",
+		},
+		{
+			name:   "invalid term",
+			output: "Server ID: ed29 (ed29b8e7-0000-4000-8000-000000000001)\nTerm: four\n",
+			err:    `failed to parse "Term: four"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := ParseStatus(tt.output)
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, status)
+		})
+	}
+}
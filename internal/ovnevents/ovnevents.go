@@ -0,0 +1,496 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovnevents
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ovn-org/libovsdb/cache"
+	"github.com/ovn-org/libovsdb/client"
+	"github.com/ovn-org/libovsdb/model"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/sbdb"
+	"k8s.io/utils/ptr"
+
+	"github.com/vexxhost/atmosphere/internal/ovnhealth"
+)
+
+// Type is the type of an event
+type Type string
+
+const (
+	// TypeRouterMoved is emitted when the chassis hosting the gateway port of a
+	// router changes
+	TypeRouterMoved Type = "RouterMoved"
+
+	// TypeChassisUp is emitted when a chassis becomes alive according to
+	// ovnhealth, including when it registers in the southbound database
+	TypeChassisUp Type = "ChassisUp"
+
+	// TypeChassisDown is emitted when a chassis is no longer alive according to
+	// ovnhealth, such as when it crashed or is partitioned and keeps its row in
+	// the southbound database
+	TypeChassisDown Type = "ChassisDown"
+
+	// TypeChassisRemoved is emitted when a chassis is removed from the
+	// southbound database, which only happens when it is stopped cleanly
+	TypeChassisRemoved Type = "ChassisRemoved"
+
+	// TypePortBound is emitted when a port is bound to a chassis, or moves from
+	// one chassis to another
+	TypePortBound Type = "PortBound"
+
+	// TypePortUnbound is emitted when a port is no longer bound to any chassis
+	TypePortUnbound Type = "PortUnbound"
+)
+
+// Event is a change observed in the OVN databases
+type Event struct {
+	// Time is the time the change was observed
+	Time time.Time `json:"time"`
+
+	// Type is the type of the event
+	Type Type `json:"type"`
+
+	// Object is a reference to the changed object, such as `router/<uuid>`
+	Object string `json:"object"`
+
+	// Message is a human readable description of the event
+	Message string `json:"message"`
+
+	// Chassis is the chassis the object is on after the change, if any
+	Chassis string `json:"chassis,omitempty"`
+
+	// PreviousChassis is the chassis the object was on before the change, if
+	// any
+	PreviousChassis string `json:"previousChassis,omitempty"`
+}
+
+// DefaultLivenessInterval is the default interval at which the liveness of
+// every chassis is evaluated again, since a chassis is considered down once
+// some time passed without it catching up with nb_cfg, which does not change
+// the southbound database
+const DefaultLivenessInterval = 5 * time.Second
+
+// NorthboundTables returns the northbound tables which must be monitored by the
+// client used by the Source
+func NorthboundTables() map[string]model.Model {
+	return map[string]model.Model{
+		nbdb.LogicalRouterTable:     &nbdb.LogicalRouter{},
+		nbdb.LogicalRouterPortTable: &nbdb.LogicalRouterPort{},
+	}
+}
+
+// SouthboundTables returns the southbound tables which must be monitored by the
+// client given to WithSouthbound
+func SouthboundTables() map[string]model.Model {
+	tables := ovnhealth.Tables()
+	tables[sbdb.PortBindingTable] = &sbdb.PortBinding{}
+
+	return tables
+}
+
+// Source emits events for the changes of the OVN databases
+type Source struct {
+	client           client.Client
+	sbClient         client.Client
+	livenessInterval time.Duration
+	now              func() time.Time
+
+	// chassisNames maps the UUID of chassis to their name, names of removed
+	// chassis are kept since port bindings can be updated after the chassis
+	// they were bound to is removed
+	mu           sync.Mutex
+	chassisNames map[string]string
+
+	// alive holds the liveness of every chassis observed by the last
+	// evaluation, keyed by chassis name
+	alive map[string]bool
+}
+
+// Option configures a Source
+type Option func(*Source)
+
+// WithSouthbound makes the Source also emit events for changes of chassis and
+// port bindings in the given southbound client
+func WithSouthbound(c client.Client) Option {
+	return func(s *Source) {
+		s.sbClient = c
+	}
+}
+
+// WithLivenessInterval sets the interval at which the liveness of every
+// chassis is evaluated again when the southbound database does not change
+func WithLivenessInterval(d time.Duration) Option {
+	return func(s *Source) {
+		s.livenessInterval = d
+	}
+}
+
+// NewSource creates a new Source instance with the given OVN client
+func NewSource(c client.Client, opts ...Option) *Source {
+	s := &Source{
+		client:           c,
+		livenessInterval: DefaultLivenessInterval,
+		now:              time.Now,
+		chassisNames:     map[string]string{},
+		alive:            map[string]bool{},
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Start watches the caches of the clients and returns a channel receiving the
+// events until the context is done. The rows which already exist when it is
+// called do not emit any events.
+//
+// Events are queued without limit until they are received, so that a slow
+// receiver never blocks the updates of the caches.
+func (s *Source) Start(ctx context.Context) (<-chan Event, error) {
+	events := make(chan Event)
+	queue := newQueue()
+	emit := func(event *Event) {
+		if event == nil {
+			return
+		}
+
+		event.Time = s.now()
+		queue.push(*event)
+	}
+
+	s.client.Cache().AddEventHandler(&cache.EventHandlerFuncs{
+		UpdateFunc: func(table string, old, new model.Model) {
+			if table != nbdb.LogicalRouterPortTable {
+				return
+			}
+
+			// NOTE: Northbound schemas older than OVN 23.09 are monitored with
+			//       a model without the status of logical router ports, see
+			//       ovnrouter.CompatibleTables, routers moving are then only
+			//       seen as PortBound events of their chassisredirect ports.
+			oldPort, ok := old.(*nbdb.LogicalRouterPort)
+			if !ok {
+				return
+			}
+			emit(s.routerMoved(ctx, oldPort, new.(*nbdb.LogicalRouterPort)))
+		},
+	})
+
+	if s.sbClient != nil {
+		if err := s.watchSouthbound(ctx, emit); err != nil {
+			return nil, err
+		}
+	}
+
+	go queue.forward(ctx, events)
+
+	return events, nil
+}
+
+// watchSouthbound emits the events for the changes of chassis and port
+// bindings in the southbound client
+func (s *Source) watchSouthbound(ctx context.Context, emit func(*Event)) error {
+	chassis := []sbdb.Chassis{}
+	if err := s.sbClient.List(ctx, &chassis); err != nil {
+		return fmt.Errorf("failed to list chassis: %w", err)
+	}
+
+	for _, c := range chassis {
+		s.setChassisName(c.UUID, c.Name)
+	}
+
+	// Record the liveness of the existing chassis without emitting events
+	checker := ovnhealth.NewChecker(s.sbClient)
+	if _, err := s.livenessChanges(ctx, checker); err != nil {
+		return err
+	}
+
+	// NOTE: The liveness is evaluated outside of the cache handlers, which
+	//       only request an evaluation when a row it depends on changed.
+	evaluate := make(chan struct{}, 1)
+	requestEvaluation := func() {
+		select {
+		case evaluate <- struct{}{}:
+		default:
+		}
+	}
+
+	s.sbClient.Cache().AddEventHandler(&cache.EventHandlerFuncs{
+		AddFunc: func(table string, m model.Model) {
+			if table == sbdb.ChassisTable {
+				chassis := m.(*sbdb.Chassis)
+				s.setChassisName(chassis.UUID, chassis.Name)
+				requestEvaluation()
+			}
+		},
+		UpdateFunc: func(table string, old, new model.Model) {
+			switch table {
+			case sbdb.PortBindingTable:
+				emit(s.portBound(old.(*sbdb.PortBinding), new.(*sbdb.PortBinding)))
+			case sbdb.ChassisTable, sbdb.ChassisPrivateTable, sbdb.SBGlobalTable:
+				requestEvaluation()
+			}
+		},
+		DeleteFunc: func(table string, m model.Model) {
+			if table == sbdb.ChassisTable {
+				emit(s.chassisRemoved(m.(*sbdb.Chassis)))
+			}
+		},
+	})
+
+	go func() {
+		ticker := time.NewTicker(s.livenessInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			case <-evaluate:
+			}
+
+			events, err := s.livenessChanges(ctx, checker)
+			if err != nil {
+				continue
+			}
+
+			for _, event := range events {
+				emit(event)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// setChassisName records the name of a chassis
+func (s *Source) setChassisName(uuid, name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.chassisNames[uuid] = name
+}
+
+// chassisName returns the name of the chassis with the given UUID, or the UUID
+// itself if the chassis is unknown
+func (s *Source) chassisName(uuid *string) string {
+	if uuid == nil {
+		return ""
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if name, ok := s.chassisNames[*uuid]; ok {
+		return name
+	}
+
+	return *uuid
+}
+
+// routerMoved returns the event for a change of the hosting chassis of a
+// logical router port, or nil if it did not change
+func (s *Source) routerMoved(ctx context.Context, old, new *nbdb.LogicalRouterPort) *Event {
+	previous := old.Status["hosting-chassis"]
+	current := new.Status["hosting-chassis"]
+	if previous == current {
+		return nil
+	}
+
+	// NOTE: Routers are referenced by the UUID of their Neutron router when
+	//       the port still belongs to one, otherwise by the port itself.
+	object := "logicalrouterport/" + new.Name
+	lrs := []nbdb.LogicalRouter{}
+	if err := s.client.WhereCache(func(lr *nbdb.LogicalRouter) bool {
+		return slices.Contains(lr.Ports, new.UUID)
+	}).List(ctx, &lrs); err == nil && len(lrs) > 0 {
+		object = "router/" + strings.TrimPrefix(lrs[0].Name, "neutron-")
+	}
+
+	event := &Event{
+		Type:            TypeRouterMoved,
+		Object:          object,
+		Chassis:         current,
+		PreviousChassis: previous,
+	}
+
+	switch {
+	case previous == "":
+		event.Message = fmt.Sprintf("Router is now hosted on %s", current)
+	case current == "":
+		event.Message = fmt.Sprintf("Router is no longer hosted on %s", previous)
+	default:
+		event.Message = fmt.Sprintf("Router moved from %s to %s", previous, current)
+	}
+
+	return event
+}
+
+// livenessChanges evaluates the liveness of every chassis and returns the
+// events for the chassis whose liveness changed since the previous evaluation
+func (s *Source) livenessChanges(ctx context.Context, checker *ovnhealth.Checker) ([]*Event, error) {
+	statuses, err := checker.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var events []*Event
+	seen := make(map[string]bool, len(statuses))
+	for _, status := range statuses {
+		seen[status.Name] = true
+
+		alive, known := s.alive[status.Name]
+		s.alive[status.Name] = status.Alive
+
+		switch {
+		case !known && status.Alive:
+			events = append(events, &Event{
+				Type:    TypeChassisUp,
+				Object:  "chassis/" + status.Name,
+				Message: fmt.Sprintf("Chassis registered with hostname %s", status.Hostname),
+				Chassis: status.Name,
+			})
+		case known && !alive && status.Alive:
+			events = append(events, &Event{
+				Type:    TypeChassisUp,
+				Object:  "chassis/" + status.Name,
+				Message: "Chassis is alive again",
+				Chassis: status.Name,
+			})
+		case known && alive && !status.Alive:
+			events = append(events, &Event{
+				Type:    TypeChassisDown,
+				Object:  "chassis/" + status.Name,
+				Message: fmt.Sprintf("Chassis is not alive, it is %d nb_cfg update(s) behind", status.NbCfgLag),
+				Chassis: status.Name,
+			})
+		}
+	}
+
+	for name := range s.alive {
+		if !seen[name] {
+			delete(s.alive, name)
+		}
+	}
+
+	return events, nil
+}
+
+// chassisRemoved returns the event for a chassis removed from the southbound
+// database
+func (s *Source) chassisRemoved(chassis *sbdb.Chassis) *Event {
+	// NOTE: The chassis is forgotten so that it is reported as registered if
+	//       it comes back before the liveness is evaluated again.
+	s.mu.Lock()
+	delete(s.alive, chassis.Name)
+	s.mu.Unlock()
+
+	return &Event{
+		Type:            TypeChassisRemoved,
+		Object:          "chassis/" + chassis.Name,
+		Message:         "Chassis removed from the southbound database",
+		PreviousChassis: chassis.Name,
+	}
+}
+
+// portBound returns the event for a change of the chassis a port is bound to,
+// or nil if it did not change
+func (s *Source) portBound(old, new *sbdb.PortBinding) *Event {
+	if ptr.Equal(old.Chassis, new.Chassis) {
+		return nil
+	}
+
+	previous := s.chassisName(old.Chassis)
+	current := s.chassisName(new.Chassis)
+
+	event := &Event{
+		Type:            TypePortBound,
+		Object:          "port/" + new.LogicalPort,
+		Chassis:         current,
+		PreviousChassis: previous,
+	}
+
+	switch {
+	case current == "":
+		event.Type = TypePortUnbound
+		event.Message = fmt.Sprintf("Port unbound from %s", previous)
+	case previous == "":
+		event.Message = fmt.Sprintf("Port bound to %s", current)
+	default:
+		event.Message = fmt.Sprintf("Port moved from %s to %s", previous, current)
+	}
+
+	return event
+}
+
+// queue buffers the events emitted by the cache handlers until they are
+// received
+type queue struct {
+	mu     sync.Mutex
+	events []Event
+	ready  chan struct{}
+}
+
+// newQueue creates a new empty queue
+func newQueue() *queue {
+	return &queue{
+		ready: make(chan struct{}, 1),
+	}
+}
+
+// push adds an event to the queue without blocking
+func (q *queue) push(event Event) {
+	q.mu.Lock()
+	q.events = append(q.events, event)
+	q.mu.Unlock()
+
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
+
+// pop removes and returns all the queued events
+func (q *queue) pop() []Event {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	events := q.events
+	q.events = nil
+
+	return events
+}
+
+// forward sends the queued events in order to the given channel until the
+// context is done
+func (q *queue) forward(ctx context.Context, out chan<- Event) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-q.ready:
+		}
+
+		for _, event := range q.pop() {
+			select {
+			case <-ctx.Done():
+				return
+			case out <- event:
+			}
+		}
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovnevents
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/sbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	testRouterUUID   = "266b4831-c71b-46f0-bfdc-a0bd189db632"
+	testPortUUID     = "0f1e2d3c-4b5a-4968-8776-655443322110"
+	testChassisUUID  = "aa3fd293-3f8c-42f9-9d72-4afa984727b3"
+	testChassisUUID2 = "bb4fd293-3f8c-42f9-9d72-4afa984727b3"
+)
+
+var testTime = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// receive returns the next event or fails the test after a timeout
+func receive(t *testing.T, events <-chan Event) Event {
+	t.Helper()
+
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for event")
+		return Event{}
+	}
+}
+
+func TestSource(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	nbClient, sbClient, cleanup, err := libovsdb.NewNBSBTestHarness(libovsdb.TestSetup{
+		NBData: []libovsdb.TestData{
+			&nbdb.LogicalRouterPort{
+				UUID:   "lrp-gw",
+				Name:   "lrp-gw",
+				Status: map[string]string{"hosting-chassis": "network-1"},
+			},
+			&nbdb.LogicalRouter{
+				Name:  "neutron-" + testRouterUUID,
+				Ports: []string{"lrp-gw"},
+			},
+		},
+		SBData: []libovsdb.TestData{
+			&sbdb.Chassis{UUID: testChassisUUID, Name: "compute-1", Hostname: "compute-1.example.com"},
+			&sbdb.PortBinding{LogicalPort: testPortUUID, TunnelKey: 1},
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(cleanup.Cleanup)
+
+	source := NewSource(nbClient, WithSouthbound(sbClient))
+	source.now = func() time.Time { return testTime }
+
+	events, err := source.Start(ctx)
+	require.NoError(t, err)
+
+	// Move the router to another chassis
+	lrp := &nbdb.LogicalRouterPort{Name: "lrp-gw", Status: map[string]string{"hosting-chassis": "network-2"}}
+	ops, err := nbClient.Where(lrp).Update(lrp, &lrp.Status)
+	require.NoError(t, err)
+	_, err = nbClient.Transact(ctx, ops...)
+	require.NoError(t, err)
+
+	assert.Equal(t, Event{
+		Time:            testTime,
+		Type:            TypeRouterMoved,
+		Object:          "router/" + testRouterUUID,
+		Message:         "Router moved from network-1 to network-2",
+		Chassis:         "network-2",
+		PreviousChassis: "network-1",
+	}, receive(t, events))
+
+	// Bind the port to the existing chassis
+	pb := &sbdb.PortBinding{LogicalPort: testPortUUID, Chassis: ptr.To(testChassisUUID)}
+	ops, err = sbClient.Where(pb).Update(pb, &pb.Chassis)
+	require.NoError(t, err)
+	_, err = sbClient.Transact(ctx, ops...)
+	require.NoError(t, err)
+
+	assert.Equal(t, Event{
+		Time:    testTime,
+		Type:    TypePortBound,
+		Object:  "port/" + testPortUUID,
+		Message: "Port bound to compute-1",
+		Chassis: "compute-1",
+	}, receive(t, events))
+
+	// Register a new chassis
+	chassis := &sbdb.Chassis{UUID: testChassisUUID2, Name: "compute-2", Hostname: "compute-2.example.com"}
+	ops, err = sbClient.Create(chassis)
+	require.NoError(t, err)
+	_, err = sbClient.Transact(ctx, ops...)
+	require.NoError(t, err)
+
+	assert.Equal(t, Event{
+		Time:    testTime,
+		Type:    TypeChassisUp,
+		Object:  "chassis/compute-2",
+		Message: "Chassis registered with hostname compute-2.example.com",
+		Chassis: "compute-2",
+	}, receive(t, events))
+}
+
+func TestSource_Liveness(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	nbClient, sbClient, cleanup, err := libovsdb.NewNBSBTestHarness(libovsdb.TestSetup{
+		SBData: []libovsdb.TestData{
+			&sbdb.SBGlobal{NbCfg: 5},
+			&sbdb.Chassis{UUID: testChassisUUID, Name: "network-1", Hostname: "network-1.example.com", NbCfg: 5},
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(cleanup.Cleanup)
+
+	// NOTE: The interval is long enough for the liveness to only be evaluated
+	//       when the southbound database changes.
+	source := NewSource(nbClient, WithSouthbound(sbClient), WithLivenessInterval(time.Hour))
+	source.now = func() time.Time { return testTime }
+
+	events, err := source.Start(ctx)
+	require.NoError(t, err)
+
+	globals := []sbdb.SBGlobal{}
+	require.NoError(t, sbClient.List(ctx, &globals))
+	require.Len(t, globals, 1)
+
+	// The chassis crashed and no longer catches up with nb_cfg
+	global := &sbdb.SBGlobal{UUID: globals[0].UUID, NbCfg: 10}
+	ops, err := sbClient.Where(global).Update(global, &global.NbCfg)
+	require.NoError(t, err)
+	_, err = sbClient.Transact(ctx, ops...)
+	require.NoError(t, err)
+
+	assert.Equal(t, Event{
+		Time:    testTime,
+		Type:    TypeChassisDown,
+		Object:  "chassis/network-1",
+		Message: "Chassis is not alive, it is 5 nb_cfg update(s) behind",
+		Chassis: "network-1",
+	}, receive(t, events))
+
+	// The chassis caught up with nb_cfg again
+	chassis := &sbdb.Chassis{Name: "network-1", NbCfg: 10}
+	ops, err = sbClient.Where(chassis).Update(chassis, &chassis.NbCfg)
+	require.NoError(t, err)
+	_, err = sbClient.Transact(ctx, ops...)
+	require.NoError(t, err)
+
+	assert.Equal(t, Event{
+		Time:    testTime,
+		Type:    TypeChassisUp,
+		Object:  "chassis/network-1",
+		Message: "Chassis is alive again",
+		Chassis: "network-1",
+	}, receive(t, events))
+
+	// The chassis is stopped cleanly
+	ops, err = sbClient.Where(chassis).Delete()
+	require.NoError(t, err)
+	_, err = sbClient.Transact(ctx, ops...)
+	require.NoError(t, err)
+
+	assert.Equal(t, Event{
+		Time:            testTime,
+		Type:            TypeChassisRemoved,
+		Object:          "chassis/network-1",
+		Message:         "Chassis removed from the southbound database",
+		PreviousChassis: "network-1",
+	}, receive(t, events))
+}
+
+func TestQueue(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Pushing must never block, even when nothing receives the events
+	q := newQueue()
+	for i := range 1000 {
+		q.push(Event{Object: fmt.Sprintf("port/%d", i)})
+	}
+
+	events := make(chan Event)
+	go q.forward(ctx, events)
+
+	for i := range 1000 {
+		assert.Equal(t, fmt.Sprintf("port/%d", i), receive(t, events).Object)
+	}
+}
+
+func TestSource_PortBound(t *testing.T) {
+	tests := []struct {
+		name     string
+		old      *string
+		new      *string
+		expected *Event
+	}{
+		{
+			name: "unchanged",
+			old:  ptr.To(testChassisUUID),
+			new:  ptr.To(testChassisUUID),
+		},
+		{
+			name: "bound",
+			new:  ptr.To(testChassisUUID),
+			expected: &Event{
+				Type:    TypePortBound,
+				Object:  "port/" + testPortUUID,
+				Message: "Port bound to compute-1",
+				Chassis: "compute-1",
+			},
+		},
+		{
+			name: "moved",
+			old:  ptr.To(testChassisUUID),
+			new:  ptr.To(testChassisUUID2),
+			expected: &Event{
+				Type:            TypePortBound,
+				Object:          "port/" + testPortUUID,
+				Message:         "Port moved from compute-1 to " + testChassisUUID2,
+				Chassis:         testChassisUUID2,
+				PreviousChassis: "compute-1",
+			},
+		},
+		{
+			name: "unbound",
+			old:  ptr.To(testChassisUUID),
+			expected: &Event{
+				Type:            TypePortUnbound,
+				Object:          "port/" + testPortUUID,
+				Message:         "Port unbound from compute-1",
+				PreviousChassis: "compute-1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := NewSource(nil)
+			source.setChassisName(testChassisUUID, "compute-1")
+
+			event := source.portBound(
+				&sbdb.PortBinding{LogicalPort: testPortUUID, Chassis: tt.old},
+				&sbdb.PortBinding{LogicalPort: testPortUUID, Chassis: tt.new},
+			)
+			assert.Equal(t, tt.expected, event)
+		})
+	}
+}
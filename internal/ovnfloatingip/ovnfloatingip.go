@@ -0,0 +1,227 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovnfloatingip
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/ovn-org/libovsdb/client"
+	"github.com/ovn-org/libovsdb/model"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+	"github.com/vexxhost/atmosphere/internal/ovnport"
+	"github.com/vexxhost/atmosphere/internal/ovnrouter"
+)
+
+// fipIDKey is the external ID holding the Neutron UUID of a floating IP
+const fipIDKey = "neutron:fip_id"
+
+// NorthboundTables returns the northbound tables which must be monitored by the
+// client used by the Manager
+func NorthboundTables() map[string]model.Model {
+	tables := ovnrouter.Tables()
+	tables[nbdb.NATTable] = &nbdb.NAT{}
+
+	return tables
+}
+
+// SouthboundTables returns the southbound tables which must be monitored by the
+// client given to WithSouthbound
+func SouthboundTables() map[string]model.Model {
+	return ovnport.SouthboundTables()
+}
+
+// NeedsSouthbound returns true if listing the floating IPs of the northbound
+// client needs a southbound client given to WithSouthbound, which is the case
+// when some floating IPs are distributed or when the northbound schema
+// predates the status of router ports
+func NeedsSouthbound(c client.Client) bool {
+	if !ovnrouter.HasPortStatus(c) {
+		return true
+	}
+
+	nats := []nbdb.NAT{}
+	if err := c.WhereCache(func(nat *nbdb.NAT) bool {
+		return isFloatingIP(nat) && ptr.Deref(nat.ExternalMAC, "") != "" && ptr.Deref(nat.LogicalPort, "") != ""
+	}).List(context.Background(), &nats); err != nil {
+		return true
+	}
+
+	return len(nats) > 0
+}
+
+// Manager provides methods for managing OVN floating IPs
+type Manager struct {
+	client   client.Client
+	sbClient client.Client
+}
+
+// ManagerOption configures a Manager
+type ManagerOption func(*Manager)
+
+// WithSouthbound makes the Manager look up the chassis serving distributed
+// floating IPs, and the chassis hosting routers when needed, in the given
+// southbound client
+func WithSouthbound(c client.Client) ManagerOption {
+	return func(m *Manager) {
+		m.sbClient = c
+	}
+}
+
+// NewManager creates a new Manager instance with the given OVN client
+func NewManager(c client.Client, opts ...ManagerOption) *Manager {
+	m := &Manager{
+		client: c,
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+// isFloatingIP returns true if the NAT row was created by Neutron for a
+// floating IP
+func isFloatingIP(nat *nbdb.NAT) bool {
+	_, ok := nat.ExternalIDs[fipIDKey]
+	return nat.Type == nbdb.NATTypeDNATAndSNAT && ok
+}
+
+// convertToFloatingIP converts a NAT row of the given router, the agents map
+// caches the hosting chassis of routers since they are shared by many NAT rows
+func (m *Manager) convertToFloatingIP(ctx context.Context, nat *nbdb.NAT, lr *nbdb.LogicalRouter, agents map[string]string) (*apiv1alpha1.FloatingIP, error) {
+	uuid := types.UID(nat.ExternalIDs[fipIDKey])
+
+	fip := &apiv1alpha1.FloatingIP{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "FloatingIP",
+			APIVersion: "atmosphere.vexxhost.com/v1alpha1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   string(uuid),
+			UID:    uuid,
+			Labels: maps.Clone(nat.ExternalIDs),
+		},
+		Status: apiv1alpha1.FloatingIPStatus{
+			InternalUUID: ptr.To(types.UID(nat.UUID)),
+			FloatingIP:   nat.ExternalIP,
+			FixedIP:      nat.LogicalIP,
+			LogicalPort:  ptr.Deref(nat.LogicalPort, ""),
+			ExternalMAC:  ptr.Deref(nat.ExternalMAC, ""),
+		},
+	}
+
+	// NOTE: Distributed floating IPs are answered by the chassis hosting the
+	//       port using the external MAC, the others are centralized on the
+	//       gateway chassis of the router.
+	fip.Status.Distributed = fip.Status.ExternalMAC != "" && fip.Status.LogicalPort != ""
+
+	if lr != nil {
+		fip.Status.RouterID = types.UID(strings.TrimPrefix(lr.Name, "neutron-"))
+	}
+
+	switch {
+	case fip.Status.Distributed && m.sbClient != nil:
+		chassis, err := ovnport.BindingChassis(ctx, m.sbClient, fip.Status.LogicalPort)
+		if err != nil {
+			return nil, err
+		}
+
+		fip.Status.Chassis = chassis
+	case !fip.Status.Distributed && lr != nil:
+		agent, ok := agents[lr.UUID]
+		if !ok {
+			router, err := ovnrouter.NewManager(m.client, ovnrouter.WithSouthbound(m.sbClient)).GetByUUID(ctx, fip.Status.RouterID)
+			if err != nil {
+				return nil, err
+			}
+
+			agent = router.Status.Agent
+			agents[lr.UUID] = agent
+		}
+
+		fip.Status.Chassis = agent
+	}
+
+	return fip, nil
+}
+
+// getRouters returns the router of every NAT row
+func (m *Manager) getRouters(ctx context.Context) (map[string]*nbdb.LogicalRouter, error) {
+	var lrs []nbdb.LogicalRouter
+	if err := m.client.List(ctx, &lrs); err != nil {
+		return nil, fmt.Errorf("failed to list routers: %w", err)
+	}
+
+	routers := make(map[string]*nbdb.LogicalRouter)
+	for i := range lrs {
+		for _, natUUID := range lrs[i].Nat {
+			routers[natUUID] = &lrs[i]
+		}
+	}
+
+	return routers, nil
+}
+
+// GetByUUID retrieves a floating IP by its Neutron UUID
+func (m *Manager) GetByUUID(ctx context.Context, uuid types.UID) (*apiv1alpha1.FloatingIP, error) {
+	nats := []nbdb.NAT{}
+	if err := m.client.WhereCache(func(nat *nbdb.NAT) bool {
+		return isFloatingIP(nat) && nat.ExternalIDs[fipIDKey] == string(uuid)
+	}).List(ctx, &nats); err != nil {
+		return nil, fmt.Errorf("failed to get floating IP %q: %w", uuid, err)
+	}
+
+	if len(nats) == 0 {
+		return nil, fmt.Errorf("floating IP %q not found", uuid)
+	}
+
+	routers, err := m.getRouters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.convertToFloatingIP(ctx, &nats[0], routers[nats[0].UUID], map[string]string{})
+}
+
+// List retrieves all floating IPs
+func (m *Manager) List(ctx context.Context) (*apiv1alpha1.FloatingIPList, error) {
+	var nats []nbdb.NAT
+	if err := m.client.WhereCache(isFloatingIP).List(ctx, &nats); err != nil {
+		return nil, err
+	}
+
+	routers, err := m.getRouters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &apiv1alpha1.FloatingIPList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "FloatingIPList",
+			APIVersion: "atmosphere.vexxhost.com/v1alpha1",
+		},
+		Items: make([]apiv1alpha1.FloatingIP, 0, len(nats)),
+	}
+
+	agents := map[string]string{}
+	for _, nat := range nats {
+		fip, err := m.convertToFloatingIP(ctx, &nat, routers[nat.UUID], agents)
+		if err != nil {
+			continue
+		}
+
+		result.Items = append(result.Items, *fip)
+	}
+
+	return result, nil
+}
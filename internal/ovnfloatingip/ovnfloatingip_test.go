@@ -0,0 +1,160 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovnfloatingip
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/sbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+)
+
+const (
+	testRouterUUID  = "266b4831-c71b-46f0-bfdc-a0bd189db632"
+	testFIPUUID     = "3a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"
+	testFIPUUID2    = "4b2c3d4e-5f6a-4b7c-9d8e-0f1a2b3c4d5e"
+	testPortUUID    = "0f1e2d3c-4b5a-4968-8776-655443322110"
+	testChassisUUID = "aa3fd293-3f8c-42f9-9d72-4afa984727b3"
+)
+
+func TestList(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	nbClient, sbClient, cleanup, err := libovsdb.NewNBSBTestHarness(libovsdb.TestSetup{
+		NBData: []libovsdb.TestData{
+			&nbdb.NAT{
+				UUID:        "nat-centralized",
+				Type:        nbdb.NATTypeDNATAndSNAT,
+				ExternalIP:  "203.0.113.10",
+				LogicalIP:   "10.0.0.5",
+				ExternalIDs: map[string]string{"neutron:fip_id": testFIPUUID},
+			},
+			&nbdb.NAT{
+				UUID:        "nat-distributed",
+				Type:        nbdb.NATTypeDNATAndSNAT,
+				ExternalIP:  "203.0.113.11",
+				LogicalIP:   "10.0.0.6",
+				LogicalPort: ptr.To(testPortUUID),
+				ExternalMAC: ptr.To("fa:16:3e:00:00:02"),
+				ExternalIDs: map[string]string{"neutron:fip_id": testFIPUUID2},
+			},
+			&nbdb.NAT{
+				UUID:       "nat-snat",
+				Type:       nbdb.NATTypeSNAT,
+				ExternalIP: "203.0.113.1",
+				LogicalIP:  "10.0.0.0/24",
+			},
+			&nbdb.LogicalRouterPort{
+				UUID:        "lrp-gw",
+				Name:        "lrp-gw",
+				ExternalIDs: map[string]string{"neutron:is_ext_gw": "True"},
+				Status:      map[string]string{"hosting-chassis": "network-1"},
+			},
+			&nbdb.LogicalRouter{
+				Name:  "neutron-" + testRouterUUID,
+				Ports: []string{"lrp-gw"},
+				Nat:   []string{"nat-centralized", "nat-distributed", "nat-snat"},
+			},
+		},
+		SBData: []libovsdb.TestData{
+			&sbdb.Chassis{UUID: testChassisUUID, Name: "compute-1"},
+			&sbdb.PortBinding{LogicalPort: testPortUUID, TunnelKey: 1, Chassis: ptr.To(testChassisUUID)},
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(cleanup.Cleanup)
+
+	manager := NewManager(nbClient, WithSouthbound(sbClient))
+	list, err := manager.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, list.Items, 2)
+
+	fips := map[types.UID]apiv1alpha1.FloatingIPStatus{}
+	for _, fip := range list.Items {
+		fips[fip.UID] = fip.Status
+	}
+
+	assert.Equal(t, "203.0.113.10", fips[testFIPUUID].FloatingIP)
+	assert.False(t, fips[testFIPUUID].Distributed)
+	assert.Equal(t, "network-1", fips[testFIPUUID].Chassis)
+	assert.Equal(t, types.UID(testRouterUUID), fips[testFIPUUID].RouterID)
+
+	assert.Equal(t, "203.0.113.11", fips[testFIPUUID2].FloatingIP)
+	assert.True(t, fips[testFIPUUID2].Distributed)
+	assert.Equal(t, "compute-1", fips[testFIPUUID2].Chassis)
+	assert.Equal(t, types.UID(testRouterUUID), fips[testFIPUUID2].RouterID)
+}
+
+func TestGetByUUID_NotFound(t *testing.T) {
+	nbClient, cleanup, err := libovsdb.NewNBTestHarness(libovsdb.TestSetup{}, nil)
+	require.NoError(t, err)
+	t.Cleanup(cleanup.Cleanup)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err = NewManager(nbClient).GetByUUID(ctx, testFIPUUID)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestNeedsSouthbound(t *testing.T) {
+	tests := []struct {
+		name     string
+		nat      *nbdb.NAT
+		expected bool
+	}{
+		{
+			name: "centralized floating IP",
+			nat: &nbdb.NAT{
+				UUID:        "nat",
+				Type:        nbdb.NATTypeDNATAndSNAT,
+				ExternalIP:  "203.0.113.10",
+				LogicalIP:   "10.0.0.5",
+				ExternalIDs: map[string]string{"neutron:fip_id": testFIPUUID},
+			},
+		},
+		{
+			name: "distributed floating IP",
+			nat: &nbdb.NAT{
+				UUID:        "nat",
+				Type:        nbdb.NATTypeDNATAndSNAT,
+				ExternalIP:  "203.0.113.11",
+				LogicalIP:   "10.0.0.6",
+				LogicalPort: ptr.To(testPortUUID),
+				ExternalMAC: ptr.To("fa:16:3e:00:00:02"),
+				ExternalIDs: map[string]string{"neutron:fip_id": testFIPUUID2},
+			},
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nbClient, cleanup, err := libovsdb.NewNBTestHarness(libovsdb.TestSetup{
+				NBData: []libovsdb.TestData{
+					tt.nat,
+					&nbdb.LogicalRouter{
+						Name: "neutron-" + testRouterUUID,
+						Nat:  []string{"nat"},
+					},
+				},
+			}, nil)
+			require.NoError(t, err)
+			t.Cleanup(cleanup.Cleanup)
+
+			assert.Equal(t, tt.expected, NeedsSouthbound(nbClient))
+		})
+	}
+}
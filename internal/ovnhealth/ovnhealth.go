@@ -0,0 +1,227 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovnhealth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ovn-org/libovsdb/client"
+	"github.com/ovn-org/libovsdb/model"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/sbdb"
+)
+
+const (
+	// DefaultMaxNbCfgLag is the default number of nb_cfg sequence numbers a
+	// chassis can lag behind before it is considered down
+	DefaultMaxNbCfgLag = 1
+
+	// DefaultAgentDownTime is the default time since a chassis last caught up
+	// with nb_cfg after which it is considered down, matching the default
+	// `agent_down_time` of Neutron
+	DefaultAgentDownTime = 75 * time.Second
+
+	// gatewayCMSOption is the CMS option set on chassis which can host gateway
+	// router ports
+	gatewayCMSOption = "enable-chassis-as-gw"
+)
+
+// ErrChassisNotFound is returned when a chassis does not exist in the
+// Southbound database
+var ErrChassisNotFound = errors.New("chassis not found")
+
+// Tables returns the Southbound tables which must be monitored by the client
+// used by the Checker
+func Tables() map[string]model.Model {
+	return map[string]model.Model{
+		sbdb.ChassisTable:        &sbdb.Chassis{},
+		sbdb.ChassisPrivateTable: &sbdb.ChassisPrivate{},
+		sbdb.SBGlobalTable:       &sbdb.SBGlobal{},
+	}
+}
+
+// Status is the observed health of a chassis
+type Status struct {
+	// Name is the name of the chassis
+	Name string
+
+	// Hostname is the hostname of the chassis
+	Hostname string
+
+	// Alive indicates if the chassis is keeping up with nb_cfg updates
+	Alive bool
+
+	// GatewayCapable indicates if the chassis can host gateway router ports
+	GatewayCapable bool
+
+	// NbCfgLag is the number of nb_cfg sequence numbers the chassis is behind
+	NbCfgLag int
+
+	// LastSeen is the last time the chassis caught up with nb_cfg, if known
+	LastSeen *time.Time
+}
+
+// Checker checks the health of chassis using the Southbound database
+type Checker struct {
+	client        client.Client
+	maxNbCfgLag   int
+	agentDownTime time.Duration
+	now           func() time.Time
+}
+
+// Option configures a Checker
+type Option func(*Checker)
+
+// WithMaxNbCfgLag sets the number of nb_cfg sequence numbers a chassis can lag
+// behind before it is considered down
+func WithMaxNbCfgLag(lag int) Option {
+	return func(c *Checker) {
+		c.maxNbCfgLag = lag
+	}
+}
+
+// WithAgentDownTime sets the time since a chassis last caught up with nb_cfg
+// after which it is considered down
+func WithAgentDownTime(d time.Duration) Option {
+	return func(c *Checker) {
+		c.agentDownTime = d
+	}
+}
+
+// NewChecker creates a new Checker with the given Southbound client, which must
+// be monitoring the tables returned by Tables
+func NewChecker(c client.Client, opts ...Option) *Checker {
+	checker := &Checker{
+		client:        c,
+		maxNbCfgLag:   DefaultMaxNbCfgLag,
+		agentDownTime: DefaultAgentDownTime,
+		now:           time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(checker)
+	}
+
+	return checker
+}
+
+// Status retrieves the health of the chassis with the given name
+func (c *Checker) Status(ctx context.Context, name string) (*Status, error) {
+	chassis := []sbdb.Chassis{}
+	if err := c.client.WhereCache(func(ch *sbdb.Chassis) bool {
+		return ch.Name == name
+	}).List(ctx, &chassis); err != nil {
+		return nil, fmt.Errorf("failed to get chassis %q: %w", name, err)
+	}
+
+	if len(chassis) == 0 {
+		return nil, fmt.Errorf("%w: %q", ErrChassisNotFound, name)
+	}
+
+	return c.status(ctx, &chassis[0])
+}
+
+// List retrieves the health of all chassis
+func (c *Checker) List(ctx context.Context) ([]Status, error) {
+	chassis := []sbdb.Chassis{}
+	if err := c.client.List(ctx, &chassis); err != nil {
+		return nil, fmt.Errorf("failed to list chassis: %w", err)
+	}
+
+	statuses := make([]Status, 0, len(chassis))
+	for _, ch := range chassis {
+		status, err := c.status(ctx, &ch)
+		if err != nil {
+			return nil, err
+		}
+
+		statuses = append(statuses, *status)
+	}
+
+	return statuses, nil
+}
+
+// CheckGatewayChassis returns an error describing why the chassis with the given
+// name can not host gateway router ports, or nil if it is alive and gateway capable
+func (c *Checker) CheckGatewayChassis(ctx context.Context, name string) error {
+	status, err := c.Status(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if !status.GatewayCapable {
+		return fmt.Errorf("chassis %q is not gateway capable, %q is not set in ovn-cms-options", name, gatewayCMSOption)
+	}
+
+	if !status.Alive {
+		return fmt.Errorf("chassis %q is not alive, it is %d nb_cfg update(s) behind", name, status.NbCfgLag)
+	}
+
+	return nil
+}
+
+// status computes the health of a chassis row
+func (c *Checker) status(ctx context.Context, chassis *sbdb.Chassis) (*Status, error) {
+	status := &Status{
+		Name:           chassis.Name,
+		Hostname:       chassis.Hostname,
+		GatewayCapable: IsGatewayCapable(chassis),
+	}
+
+	globals := []sbdb.SBGlobal{}
+	if err := c.client.List(ctx, &globals); err != nil {
+		return nil, fmt.Errorf("failed to get southbound global configuration: %w", err)
+	}
+
+	nbCfg := 0
+	if len(globals) > 0 {
+		nbCfg = globals[0].NbCfg
+	}
+
+	// NOTE: Chassis_Private holds the nb_cfg of the chassis since OVN 20.09,
+	//       older versions only update the deprecated Chassis column.
+	chassisNbCfg := chassis.NbCfg
+	privates := []sbdb.ChassisPrivate{}
+	if err := c.client.WhereCache(func(cp *sbdb.ChassisPrivate) bool {
+		return cp.Name == chassis.Name
+	}).List(ctx, &privates); err != nil {
+		return nil, fmt.Errorf("failed to get private chassis %q: %w", chassis.Name, err)
+	}
+
+	if len(privates) > 0 {
+		chassisNbCfg = privates[0].NbCfg
+
+		if privates[0].NbCfgTimestamp > 0 {
+			lastSeen := time.UnixMilli(int64(privates[0].NbCfgTimestamp))
+			status.LastSeen = &lastSeen
+		}
+	}
+
+	status.NbCfgLag = max(nbCfg-chassisNbCfg, 0)
+	status.Alive = status.NbCfgLag <= c.maxNbCfgLag ||
+		(status.LastSeen != nil && c.now().Sub(*status.LastSeen) < c.agentDownTime)
+
+	return status, nil
+}
+
+// IsGatewayCapable returns true if the chassis is configured to host gateway
+// router ports, looking at `other_config` and falling back to `external_ids`
+// for older OVN versions
+func IsGatewayCapable(chassis *sbdb.Chassis) bool {
+	options, ok := chassis.OtherConfig["ovn-cms-options"]
+	if !ok {
+		options = chassis.ExternalIDs["ovn-cms-options"]
+	}
+
+	for _, option := range strings.Split(options, ",") {
+		if strings.TrimSpace(option) == gatewayCMSOption {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,131 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovnhealth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/sbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecker_CheckGatewayChassis(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		sbData        []libovsdb.TestData
+		chassis       string
+		expectError   bool
+		errorContains string
+	}{
+		{
+			name: "alive gateway chassis",
+			sbData: []libovsdb.TestData{
+				&sbdb.SBGlobal{NbCfg: 10},
+				&sbdb.Chassis{
+					Name:        "gwc-1",
+					Hostname:    "network-node-1",
+					OtherConfig: map[string]string{"ovn-cms-options": "enable-chassis-as-gw,availability-zones=nova"},
+				},
+				&sbdb.ChassisPrivate{Name: "gwc-1", NbCfg: 10},
+			},
+			chassis: "gwc-1",
+		},
+		{
+			name: "gateway capability in external ids",
+			sbData: []libovsdb.TestData{
+				&sbdb.SBGlobal{NbCfg: 10},
+				&sbdb.Chassis{
+					Name:        "gwc-1",
+					ExternalIDs: map[string]string{"ovn-cms-options": "enable-chassis-as-gw"},
+				},
+				&sbdb.ChassisPrivate{Name: "gwc-1", NbCfg: 9},
+			},
+			chassis: "gwc-1",
+		},
+		{
+			name: "not gateway capable",
+			sbData: []libovsdb.TestData{
+				&sbdb.SBGlobal{NbCfg: 10},
+				&sbdb.Chassis{Name: "compute-1"},
+				&sbdb.ChassisPrivate{Name: "compute-1", NbCfg: 10},
+			},
+			chassis:       "compute-1",
+			expectError:   true,
+			errorContains: "not gateway capable",
+		},
+		{
+			name: "lagging behind nb_cfg",
+			sbData: []libovsdb.TestData{
+				&sbdb.SBGlobal{NbCfg: 10},
+				&sbdb.Chassis{
+					Name:        "gwc-1",
+					OtherConfig: map[string]string{"ovn-cms-options": "enable-chassis-as-gw"},
+				},
+				&sbdb.ChassisPrivate{
+					Name:           "gwc-1",
+					NbCfg:          5,
+					NbCfgTimestamp: int(now.Add(-10 * time.Minute).UnixMilli()),
+				},
+			},
+			chassis:       "gwc-1",
+			expectError:   true,
+			errorContains: "not alive",
+		},
+		{
+			name: "lagging behind nb_cfg but recently seen",
+			sbData: []libovsdb.TestData{
+				&sbdb.SBGlobal{NbCfg: 10},
+				&sbdb.Chassis{
+					Name:        "gwc-1",
+					OtherConfig: map[string]string{"ovn-cms-options": "enable-chassis-as-gw"},
+				},
+				&sbdb.ChassisPrivate{
+					Name:           "gwc-1",
+					NbCfg:          5,
+					NbCfgTimestamp: int(now.Add(-10 * time.Second).UnixMilli()),
+				},
+			},
+			chassis: "gwc-1",
+		},
+		{
+			name: "missing chassis",
+			sbData: []libovsdb.TestData{
+				&sbdb.SBGlobal{NbCfg: 10},
+			},
+			chassis:       "gwc-1",
+			expectError:   true,
+			errorContains: "chassis not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			sbClient, cleanup, err := libovsdb.NewSBTestHarness(libovsdb.TestSetup{
+				SBData: tt.sbData,
+			}, nil)
+			require.NoError(t, err)
+			t.Cleanup(cleanup.Cleanup)
+
+			checker := NewChecker(sbClient)
+			checker.now = func() time.Time { return now }
+
+			err = checker.CheckGatewayChassis(ctx, tt.chassis)
+			if tt.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorContains)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,348 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovnloadbalancer
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ovn-org/libovsdb/client"
+	"github.com/ovn-org/libovsdb/model"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/sbdb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+)
+
+const (
+	// vipKey is the external ID holding the virtual IP address of load
+	// balancers created by the Octavia OVN provider
+	vipKey = "neutron:vip"
+
+	// vipFIPKey is the external ID holding the floating IP address of the
+	// virtual IP of load balancers created by the Octavia OVN provider
+	vipFIPKey = "neutron:vip_fip"
+)
+
+// NorthboundTables returns the northbound tables which must be monitored by the
+// client used by the Manager
+func NorthboundTables() map[string]model.Model {
+	return map[string]model.Model{
+		nbdb.LoadBalancerTable:            &nbdb.LoadBalancer{},
+		nbdb.LoadBalancerHealthCheckTable: &nbdb.LoadBalancerHealthCheck{},
+		nbdb.LogicalRouterTable:           &nbdb.LogicalRouter{},
+		nbdb.LogicalSwitchTable:           &nbdb.LogicalSwitch{},
+	}
+}
+
+// SouthboundTables returns the southbound tables which must be monitored by the
+// client given to WithSouthbound
+func SouthboundTables() map[string]model.Model {
+	return map[string]model.Model{
+		sbdb.ServiceMonitorTable: &sbdb.ServiceMonitor{},
+	}
+}
+
+// Manager provides methods for managing OVN load balancers
+type Manager struct {
+	client   client.Client
+	sbClient client.Client
+}
+
+// ManagerOption configures a Manager
+type ManagerOption func(*Manager)
+
+// WithSouthbound makes the Manager look up the health check status of members
+// in the given southbound client
+func WithSouthbound(c client.Client) ManagerOption {
+	return func(m *Manager) {
+		m.sbClient = c
+	}
+}
+
+// NewManager creates a new Manager instance with the given OVN client
+func NewManager(c client.Client, opts ...ManagerOption) *Manager {
+	m := &Manager{
+		client: c,
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+// isOctaviaLoadBalancer returns true if the load balancer was created by the
+// Octavia OVN provider
+func isOctaviaLoadBalancer(lb *nbdb.LoadBalancer) bool {
+	_, ok := lb.ExternalIDs[vipKey]
+	return ok
+}
+
+// convertToLoadBalancer converts the rows of a load balancer, the Octavia OVN
+// provider creates one row per protocol sharing the same name
+func (m *Manager) convertToLoadBalancer(ctx context.Context, uuid types.UID, rows []nbdb.LoadBalancer, monitors map[string]string) (*apiv1alpha1.LoadBalancer, error) {
+	lb := &apiv1alpha1.LoadBalancer{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "LoadBalancer",
+			APIVersion: "atmosphere.vexxhost.com/v1alpha1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: string(uuid),
+			UID:  uuid,
+		},
+	}
+
+	rowUUIDs := make([]string, 0, len(rows))
+	for _, row := range rows {
+		rowUUIDs = append(rowUUIDs, row.UUID)
+		lb.Status.InternalUUIDs = append(lb.Status.InternalUUIDs, types.UID(row.UUID))
+
+		if len(row.ExternalIDs) > 0 {
+			if lb.Labels == nil {
+				lb.Labels = map[string]string{}
+			}
+			maps.Copy(lb.Labels, row.ExternalIDs)
+		}
+
+		if vip := row.ExternalIDs[vipKey]; vip != "" {
+			lb.Status.VIP = vip
+		}
+		if fip := row.ExternalIDs[vipFIPKey]; fip != "" {
+			lb.Status.FloatingIP = fip
+		}
+
+		protocol := nbdb.LoadBalancerProtocolTCP
+		if row.Protocol != nil {
+			protocol = *row.Protocol
+		}
+
+		healthChecked := map[string]bool{}
+		for _, hcUUID := range row.HealthCheck {
+			hc := nbdb.LoadBalancerHealthCheck{UUID: hcUUID}
+			if err := m.client.Get(ctx, &hc); err != nil {
+				return nil, fmt.Errorf("failed to get health check %q for load balancer %q: %w", hcUUID, uuid, err)
+			}
+
+			healthChecked[hc.Vip] = true
+		}
+
+		for vip, backends := range row.Vips {
+			listener := apiv1alpha1.LoadBalancerListener{
+				Protocol:      protocol,
+				VIP:           vip,
+				HealthChecked: healthChecked[vip],
+			}
+
+			for _, backend := range strings.Split(backends, ",") {
+				backend = strings.TrimSpace(backend)
+				if backend == "" {
+					continue
+				}
+
+				member := apiv1alpha1.LoadBalancerMember{Address: backend}
+				if listener.HealthChecked {
+					member.Status = monitors[monitorKey(protocol, memberLogicalPort(&row, backend), backend)]
+				}
+
+				listener.Members = append(listener.Members, member)
+			}
+
+			sort.Slice(listener.Members, func(i, j int) bool {
+				return listener.Members[i].Address < listener.Members[j].Address
+			})
+
+			lb.Status.Listeners = append(lb.Status.Listeners, listener)
+		}
+	}
+
+	slices.Sort(lb.Status.InternalUUIDs)
+
+	sort.Slice(lb.Status.Listeners, func(i, j int) bool {
+		a, b := lb.Status.Listeners[i], lb.Status.Listeners[j]
+		if a.Protocol != b.Protocol {
+			return a.Protocol < b.Protocol
+		}
+
+		return a.VIP < b.VIP
+	})
+
+	routers, networks, err := m.getAttachments(ctx, rowUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	lb.Status.Routers = routers
+	lb.Status.Networks = networks
+
+	return lb, nil
+}
+
+// monitorKey returns the key used to match a member with its service monitor,
+// the logical port tells apart members with the same address on different
+// networks
+func monitorKey(protocol, logicalPort, address string) string {
+	return protocol + "/" + logicalPort + "/" + address
+}
+
+// memberLogicalPort returns the logical port of a member from the
+// ip_port_mappings of the load balancer row, which map the IP address of every
+// health checked member to "logical_port:source_ip", or an empty string if the
+// member has no mapping
+func memberLogicalPort(row *nbdb.LoadBalancer, address string) string {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+
+	mapping, ok := row.IPPortMappings[host]
+	if !ok {
+		// NOTE: IPv6 addresses may be mapped with brackets.
+		mapping = row.IPPortMappings["["+host+"]"]
+	}
+
+	logicalPort, _, _ := strings.Cut(mapping, ":")
+	return logicalPort
+}
+
+// getServiceMonitors returns the status of every service monitor keyed by
+// protocol, logical port and address, or nil if the Manager has no southbound
+// client
+func (m *Manager) getServiceMonitors(ctx context.Context) (map[string]string, error) {
+	if m.sbClient == nil {
+		return nil, nil
+	}
+
+	var monitors []sbdb.ServiceMonitor
+	if err := m.sbClient.List(ctx, &monitors); err != nil {
+		return nil, fmt.Errorf("failed to list service monitors: %w", err)
+	}
+
+	statuses := make(map[string]string, len(monitors))
+	for _, monitor := range monitors {
+		protocol := sbdb.ServiceMonitorProtocolTCP
+		if monitor.Protocol != nil {
+			protocol = *monitor.Protocol
+		}
+
+		status := ""
+		if monitor.Status != nil {
+			status = *monitor.Status
+		}
+
+		address := net.JoinHostPort(monitor.IP, strconv.Itoa(monitor.Port))
+		statuses[monitorKey(protocol, monitor.LogicalPort, address)] = status
+	}
+
+	return statuses, nil
+}
+
+// getAttachments returns the UUIDs of the routers and networks which have any
+// of the given load balancer rows attached
+func (m *Manager) getAttachments(ctx context.Context, rowUUIDs []string) ([]types.UID, []types.UID, error) {
+	attached := func(lbs []string) bool {
+		for _, lb := range lbs {
+			if slices.Contains(rowUUIDs, lb) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	var lrs []nbdb.LogicalRouter
+	if err := m.client.WhereCache(func(lr *nbdb.LogicalRouter) bool {
+		return attached(lr.LoadBalancer)
+	}).List(ctx, &lrs); err != nil {
+		return nil, nil, fmt.Errorf("failed to list routers: %w", err)
+	}
+
+	var lss []nbdb.LogicalSwitch
+	if err := m.client.WhereCache(func(ls *nbdb.LogicalSwitch) bool {
+		return attached(ls.LoadBalancer)
+	}).List(ctx, &lss); err != nil {
+		return nil, nil, fmt.Errorf("failed to list networks: %w", err)
+	}
+
+	var routers []types.UID
+	for _, lr := range lrs {
+		routers = append(routers, types.UID(strings.TrimPrefix(lr.Name, "neutron-")))
+	}
+	slices.Sort(routers)
+
+	var networks []types.UID
+	for _, ls := range lss {
+		networks = append(networks, types.UID(strings.TrimPrefix(ls.Name, "neutron-")))
+	}
+	slices.Sort(networks)
+
+	return routers, networks, nil
+}
+
+// GetByUUID retrieves a load balancer by its Octavia UUID
+func (m *Manager) GetByUUID(ctx context.Context, uuid types.UID) (*apiv1alpha1.LoadBalancer, error) {
+	var rows []nbdb.LoadBalancer
+	if err := m.client.WhereCache(func(lb *nbdb.LoadBalancer) bool {
+		return lb.Name == string(uuid) && isOctaviaLoadBalancer(lb)
+	}).List(ctx, &rows); err != nil {
+		return nil, fmt.Errorf("failed to get load balancer %q: %w", uuid, err)
+	}
+
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("load balancer %q not found", uuid)
+	}
+
+	monitors, err := m.getServiceMonitors(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.convertToLoadBalancer(ctx, uuid, rows, monitors)
+}
+
+// List retrieves all load balancers created by the Octavia OVN provider
+func (m *Manager) List(ctx context.Context) (*apiv1alpha1.LoadBalancerList, error) {
+	var rows []nbdb.LoadBalancer
+	if err := m.client.WhereCache(isOctaviaLoadBalancer).List(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	monitors, err := m.getServiceMonitors(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := map[string][]nbdb.LoadBalancer{}
+	for _, row := range rows {
+		byName[row.Name] = append(byName[row.Name], row)
+	}
+
+	result := &apiv1alpha1.LoadBalancerList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "LoadBalancerList",
+			APIVersion: "atmosphere.vexxhost.com/v1alpha1",
+		},
+		Items: make([]apiv1alpha1.LoadBalancer, 0, len(byName)),
+	}
+
+	for name, rows := range byName {
+		lb, err := m.convertToLoadBalancer(ctx, types.UID(name), rows, monitors)
+		if err != nil {
+			continue
+		}
+
+		result.Items = append(result.Items, *lb)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,208 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovnloadbalancer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+)
+
+const (
+	testLoadBalancerUUID = "7d5e3c4a-9b2f-4e3a-8d0c-4f8b6a5e3c2d"
+	testRouterUUID       = "266b4831-c71b-46f0-bfdc-a0bd189db632"
+	testNetworkUUID      = "5b3c1a2e-7f0d-4c1e-9b8a-2d6f4e3c1a0b"
+)
+
+func testData() []libovsdb.TestData {
+	return []libovsdb.TestData{
+		&nbdb.LoadBalancerHealthCheck{
+			UUID: "hc-1",
+			Vip:  "10.0.0.10:80",
+		},
+		&nbdb.LoadBalancer{
+			UUID:        "lb-tcp",
+			Name:        testLoadBalancerUUID,
+			Protocol:    ptr.To(nbdb.LoadBalancerProtocolTCP),
+			HealthCheck: []string{"hc-1"},
+			ExternalIDs: map[string]string{
+				"neutron:vip":     "10.0.0.10",
+				"neutron:vip_fip": "203.0.113.10",
+			},
+			Vips: map[string]string{
+				"10.0.0.10:80": "10.0.0.6:8080,10.0.0.5:8080",
+			},
+			IPPortMappings: map[string]string{
+				"10.0.0.5": "port-5:10.0.0.2",
+				"10.0.0.6": "port-6:10.0.0.2",
+			},
+		},
+		&nbdb.LoadBalancer{
+			UUID:     "lb-udp",
+			Name:     testLoadBalancerUUID,
+			Protocol: ptr.To(nbdb.LoadBalancerProtocolUDP),
+			ExternalIDs: map[string]string{
+				"neutron:vip": "10.0.0.10",
+			},
+			Vips: map[string]string{
+				"10.0.0.10:53": "10.0.0.7:53",
+			},
+			IPPortMappings: map[string]string{
+				"10.0.0.7": "port-7:10.0.0.2",
+			},
+		},
+		&nbdb.LoadBalancer{
+			UUID: "lb-other",
+			Name: "not-octavia",
+		},
+		&nbdb.LogicalRouter{
+			Name:         "neutron-" + testRouterUUID,
+			LoadBalancer: []string{"lb-tcp", "lb-udp"},
+		},
+		&nbdb.LogicalSwitch{
+			Name:         "neutron-" + testNetworkUUID,
+			LoadBalancer: []string{"lb-udp"},
+		},
+	}
+}
+
+func TestList(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	nbClient, cleanup, err := libovsdb.NewNBTestHarness(libovsdb.TestSetup{
+		NBData: testData(),
+	}, nil)
+	require.NoError(t, err)
+	t.Cleanup(cleanup.Cleanup)
+
+	manager := NewManager(nbClient)
+	list, err := manager.List(ctx)
+	require.NoError(t, err)
+
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, types.UID(testLoadBalancerUUID), list.Items[0].UID)
+	assert.Len(t, list.Items[0].Status.InternalUUIDs, 2)
+}
+
+func TestGetByUUID(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	nbClient, cleanup, err := libovsdb.NewNBTestHarness(libovsdb.TestSetup{
+		NBData: testData(),
+	}, nil)
+	require.NoError(t, err)
+	t.Cleanup(cleanup.Cleanup)
+
+	manager := NewManager(nbClient)
+	lb, err := manager.GetByUUID(ctx, testLoadBalancerUUID)
+	require.NoError(t, err)
+
+	assert.Equal(t, "10.0.0.10", lb.Status.VIP)
+	assert.Equal(t, "203.0.113.10", lb.Status.FloatingIP)
+	assert.Equal(t, []types.UID{testRouterUUID}, lb.Status.Routers)
+	assert.Equal(t, []types.UID{testNetworkUUID}, lb.Status.Networks)
+	assert.Equal(t, []apiv1alpha1.LoadBalancerListener{
+		{
+			Protocol:      "tcp",
+			VIP:           "10.0.0.10:80",
+			HealthChecked: true,
+			Members: []apiv1alpha1.LoadBalancerMember{
+				{Address: "10.0.0.5:8080"},
+				{Address: "10.0.0.6:8080"},
+			},
+		},
+		{
+			Protocol: "udp",
+			VIP:      "10.0.0.10:53",
+			Members: []apiv1alpha1.LoadBalancerMember{
+				{Address: "10.0.0.7:53"},
+			},
+		},
+	}, lb.Status.Listeners)
+
+	_, err = manager.GetByUUID(ctx, "not-octavia")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestConvertToLoadBalancer_MemberStatus(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	nbClient, cleanup, err := libovsdb.NewNBTestHarness(libovsdb.TestSetup{
+		NBData: testData(),
+	}, nil)
+	require.NoError(t, err)
+	t.Cleanup(cleanup.Cleanup)
+
+	var rows []nbdb.LoadBalancer
+	require.NoError(t, nbClient.WhereCache(isOctaviaLoadBalancer).List(ctx, &rows))
+
+	// NOTE: The southbound test harness does not monitor the Service_Monitor
+	//       table, so the statuses are given directly.
+	monitors := map[string]string{
+		monitorKey("tcp", "port-5", "10.0.0.5:8080"): "online",
+		monitorKey("tcp", "port-6", "10.0.0.6:8080"): "offline",
+		monitorKey("udp", "port-7", "10.0.0.7:53"):   "online",
+
+		// A member with the same address on another network must not be
+		// matched
+		monitorKey("tcp", "other-port", "10.0.0.6:8080"): "online",
+	}
+
+	lb, err := NewManager(nbClient).convertToLoadBalancer(ctx, testLoadBalancerUUID, rows, monitors)
+	require.NoError(t, err)
+
+	require.Len(t, lb.Status.Listeners, 2)
+	assert.Equal(t, []apiv1alpha1.LoadBalancerMember{
+		{Address: "10.0.0.5:8080", Status: "online"},
+		{Address: "10.0.0.6:8080", Status: "offline"},
+	}, lb.Status.Listeners[0].Members)
+
+	// Members of listeners without health checks have no status
+	assert.Equal(t, []apiv1alpha1.LoadBalancerMember{
+		{Address: "10.0.0.7:53"},
+	}, lb.Status.Listeners[1].Members)
+}
+
+func TestMemberLogicalPort(t *testing.T) {
+	row := &nbdb.LoadBalancer{
+		IPPortMappings: map[string]string{
+			"10.0.0.5":  "port-5:10.0.0.2",
+			"[fd00::5]": "port-6:[fd00::2]",
+			"10.0.0.8":  "port-8:10.0.0.2:az-1",
+			"10.0.0.9":  "",
+			"fd00::9":   "port-9:[fd00::2]",
+		},
+	}
+
+	tests := []struct {
+		address  string
+		expected string
+	}{
+		{address: "10.0.0.5:8080", expected: "port-5"},
+		{address: "[fd00::5]:8080", expected: "port-6"},
+		{address: "[fd00::9]:8080", expected: "port-9"},
+		{address: "10.0.0.8:8080", expected: "port-8"},
+		{address: "10.0.0.9:8080", expected: ""},
+		{address: "10.0.0.10:8080", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			assert.Equal(t, tt.expected, memberLogicalPort(row, tt.address))
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovnnetwork
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+
+	"github.com/ovn-org/libovsdb/client"
+	"github.com/ovn-org/libovsdb/model"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+)
+
+const (
+	// NetworkTypeFlat is the type of provider networks without a VLAN tag
+	NetworkTypeFlat = "flat"
+
+	// NetworkTypeVLAN is the type of provider networks with a VLAN tag
+	NetworkTypeVLAN = "vlan"
+
+	// NetworkTypeOverlay is the type of tenant networks without a localnet port
+	NetworkTypeOverlay = "overlay"
+)
+
+// Tables returns the northbound tables which must be monitored by the client
+// used by the Manager
+func Tables() map[string]model.Model {
+	return map[string]model.Model{
+		nbdb.LogicalSwitchTable:     &nbdb.LogicalSwitch{},
+		nbdb.LogicalSwitchPortTable: &nbdb.LogicalSwitchPort{},
+	}
+}
+
+// Manager provides methods for managing OVN networks
+type Manager struct {
+	client client.Client
+}
+
+// NewManager creates a new Manager instance with the given OVN client
+func NewManager(c client.Client) *Manager {
+	return &Manager{
+		client: c,
+	}
+}
+
+func (m *Manager) convertToNetwork(ctx context.Context, ls *nbdb.LogicalSwitch) (*apiv1alpha1.Network, error) {
+	networkUUID := strings.TrimPrefix(ls.Name, "neutron-")
+	uuid := types.UID(networkUUID)
+
+	networkName := string(uuid)
+	if name, ok := ls.ExternalIDs["neutron:network_name"]; ok && name != "" {
+		networkName = name
+	}
+
+	network := &apiv1alpha1.Network{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Network",
+			APIVersion: "atmosphere.vexxhost.com/v1alpha1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   networkName,
+			UID:    uuid,
+			Labels: maps.Clone(ls.ExternalIDs),
+		},
+		Status: apiv1alpha1.NetworkStatus{
+			InternalUUID: ptr.To(types.UID(ls.UUID)),
+			NetworkType:  NetworkTypeOverlay,
+			MTU:          mtu(ls),
+		},
+	}
+
+	for _, portUUID := range ls.Ports {
+		lsp := nbdb.LogicalSwitchPort{UUID: portUUID}
+		if err := m.client.Get(ctx, &lsp); err != nil {
+			return nil, fmt.Errorf("failed to get logical switch port %q for network %q: %w", portUUID, ls.Name, err)
+		}
+
+		if lsp.Type != "localnet" {
+			network.Status.Ports++
+			continue
+		}
+
+		// NOTE: Provider networks are connected to the physical network using
+		//       a localnet port, which is tagged for VLAN networks.
+		network.Status.PhysicalNetwork = lsp.Options["network_name"]
+		network.Status.NetworkType = NetworkTypeFlat
+
+		tag := lsp.Tag
+		if tag == nil {
+			tag = lsp.TagRequest
+		}
+		if tag != nil && *tag != 0 {
+			network.Status.NetworkType = NetworkTypeVLAN
+			network.Status.SegmentationID = ptr.To(*tag)
+		}
+	}
+
+	return network, nil
+}
+
+// mtu returns the MTU of a logical switch as set by Neutron, falling back to
+// the `other_config` column
+func mtu(ls *nbdb.LogicalSwitch) int {
+	for _, value := range []string{ls.ExternalIDs["neutron:mtu"], ls.OtherConfig["mtu"]} {
+		if mtu, err := strconv.Atoi(value); err == nil {
+			return mtu
+		}
+	}
+
+	return 0
+}
+
+// GetByUUID retrieves a network by its Neutron UUID
+func (m *Manager) GetByUUID(ctx context.Context, uuid types.UID) (*apiv1alpha1.Network, error) {
+	lss := []nbdb.LogicalSwitch{}
+	if err := m.client.WhereCache(func(ls *nbdb.LogicalSwitch) bool {
+		return ls.Name == fmt.Sprintf("neutron-%s", uuid)
+	}).List(ctx, &lss); err != nil {
+		return nil, fmt.Errorf("failed to get network %q: %w", uuid, err)
+	}
+
+	if len(lss) == 0 {
+		return nil, fmt.Errorf("network %q not found", uuid)
+	}
+
+	return m.convertToNetwork(ctx, &lss[0])
+}
+
+// List retrieves all networks
+func (m *Manager) List(ctx context.Context) (*apiv1alpha1.NetworkList, error) {
+	var switches []nbdb.LogicalSwitch
+	if err := m.client.List(ctx, &switches); err != nil {
+		return nil, err
+	}
+
+	result := &apiv1alpha1.NetworkList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "NetworkList",
+			APIVersion: "atmosphere.vexxhost.com/v1alpha1",
+		},
+		Items: make([]apiv1alpha1.Network, 0, len(switches)),
+	}
+
+	for _, ls := range switches {
+		network, err := m.convertToNetwork(ctx, &ls)
+		if err != nil {
+			continue
+		}
+
+		result.Items = append(result.Items, *network)
+	}
+
+	return result, nil
+}
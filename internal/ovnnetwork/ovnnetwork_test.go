@@ -0,0 +1,160 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovnnetwork
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+)
+
+const (
+	testNetworkUUID  = "5b3c1a2e-7f0d-4c1e-9b8a-2d6f4e3c1a0b"
+	testNetworkUUID2 = "6c4d2b3f-8a1e-4d2f-8c9b-3e7a5f4d2b1c"
+)
+
+func TestList(t *testing.T) {
+	nbClient, cleanup, err := libovsdb.NewNBTestHarness(libovsdb.TestSetup{
+		NBData: []libovsdb.TestData{
+			&nbdb.LogicalSwitch{
+				Name:        "neutron-" + testNetworkUUID,
+				ExternalIDs: map[string]string{"neutron:network_name": "private"},
+			},
+			&nbdb.LogicalSwitch{
+				Name: "neutron-" + testNetworkUUID2,
+			},
+		},
+	}, nil)
+	require.NoError(t, err)
+	t.Cleanup(cleanup.Cleanup)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	manager := NewManager(nbClient)
+	list, err := manager.List(ctx)
+	require.NoError(t, err)
+
+	names := map[types.UID]string{}
+	for _, network := range list.Items {
+		names[network.UID] = network.Name
+	}
+
+	assert.Equal(t, map[types.UID]string{
+		testNetworkUUID:  "private",
+		testNetworkUUID2: testNetworkUUID2,
+	}, names)
+}
+
+func TestGetByUUID(t *testing.T) {
+	tests := []struct {
+		name     string
+		nbData   []libovsdb.TestData
+		expected apiv1alpha1.NetworkStatus
+	}{
+		{
+			name: "overlay network",
+			nbData: []libovsdb.TestData{
+				&nbdb.LogicalSwitchPort{UUID: "lsp-1", Name: "port-1"},
+				&nbdb.LogicalSwitchPort{UUID: "lsp-2", Name: "port-2"},
+				&nbdb.LogicalSwitch{
+					Name:        "neutron-" + testNetworkUUID,
+					ExternalIDs: map[string]string{"neutron:mtu": "1442"},
+					Ports:       []string{"lsp-1", "lsp-2"},
+				},
+			},
+			expected: apiv1alpha1.NetworkStatus{
+				NetworkType: NetworkTypeOverlay,
+				MTU:         1442,
+				Ports:       2,
+			},
+		},
+		{
+			name: "vlan network",
+			nbData: []libovsdb.TestData{
+				&nbdb.LogicalSwitchPort{UUID: "lsp-1", Name: "port-1"},
+				&nbdb.LogicalSwitchPort{
+					UUID:    "lsp-localnet",
+					Name:    "provnet-1",
+					Type:    "localnet",
+					Options: map[string]string{"network_name": "physnet1"},
+					Tag:     ptr.To(100),
+				},
+				&nbdb.LogicalSwitch{
+					Name:        "neutron-" + testNetworkUUID,
+					OtherConfig: map[string]string{"mtu": "1500"},
+					Ports:       []string{"lsp-1", "lsp-localnet"},
+				},
+			},
+			expected: apiv1alpha1.NetworkStatus{
+				NetworkType:     NetworkTypeVLAN,
+				PhysicalNetwork: "physnet1",
+				SegmentationID:  ptr.To(100),
+				MTU:             1500,
+				Ports:           1,
+			},
+		},
+		{
+			name: "flat network",
+			nbData: []libovsdb.TestData{
+				&nbdb.LogicalSwitchPort{
+					UUID:    "lsp-localnet",
+					Name:    "provnet-1",
+					Type:    "localnet",
+					Options: map[string]string{"network_name": "external"},
+				},
+				&nbdb.LogicalSwitch{
+					Name:  "neutron-" + testNetworkUUID,
+					Ports: []string{"lsp-localnet"},
+				},
+			},
+			expected: apiv1alpha1.NetworkStatus{
+				NetworkType:     NetworkTypeFlat,
+				PhysicalNetwork: "external",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			nbClient, cleanup, err := libovsdb.NewNBTestHarness(libovsdb.TestSetup{
+				NBData: tt.nbData,
+			}, nil)
+			require.NoError(t, err)
+			t.Cleanup(cleanup.Cleanup)
+
+			manager := NewManager(nbClient)
+			network, err := manager.GetByUUID(ctx, testNetworkUUID)
+			require.NoError(t, err)
+
+			network.Status.InternalUUID = nil
+			assert.Equal(t, tt.expected, network.Status)
+		})
+	}
+}
+
+func TestGetByUUID_NotFound(t *testing.T) {
+	nbClient, cleanup, err := libovsdb.NewNBTestHarness(libovsdb.TestSetup{}, nil)
+	require.NoError(t, err)
+	t.Cleanup(cleanup.Cleanup)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err = NewManager(nbClient).GetByUUID(ctx, testNetworkUUID)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
@@ -0,0 +1,259 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovnport
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net"
+	"strings"
+
+	"github.com/ovn-org/libovsdb/client"
+	"github.com/ovn-org/libovsdb/model"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/sbdb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+)
+
+// NorthboundTables returns the northbound tables which must be monitored by the
+// client used by the Manager
+func NorthboundTables() map[string]model.Model {
+	return map[string]model.Model{
+		nbdb.LogicalSwitchTable:     &nbdb.LogicalSwitch{},
+		nbdb.LogicalSwitchPortTable: &nbdb.LogicalSwitchPort{},
+	}
+}
+
+// SouthboundTables returns the southbound tables which must be monitored by the
+// client given to WithSouthbound
+func SouthboundTables() map[string]model.Model {
+	return map[string]model.Model{
+		sbdb.ChassisTable:     &sbdb.Chassis{},
+		sbdb.PortBindingTable: &sbdb.PortBinding{},
+	}
+}
+
+// Manager provides methods for managing OVN ports
+type Manager struct {
+	client   client.Client
+	sbClient client.Client
+}
+
+// ManagerOption configures a Manager
+type ManagerOption func(*Manager)
+
+// WithSouthbound makes the Manager look up the chassis ports are bound to in
+// the given southbound client
+func WithSouthbound(c client.Client) ManagerOption {
+	return func(m *Manager) {
+		m.sbClient = c
+	}
+}
+
+// NewManager creates a new Manager instance with the given OVN client
+func NewManager(c client.Client, opts ...ManagerOption) *Manager {
+	m := &Manager{
+		client: c,
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+// ListOptions filters the ports returned by List
+type ListOptions struct {
+	// NetworkID only returns the ports attached to the given network
+	NetworkID types.UID
+
+	// DeviceID only returns the ports used by the given device
+	DeviceID string
+}
+
+func (m *Manager) convertToPort(ctx context.Context, lsp *nbdb.LogicalSwitchPort, networkID types.UID) (*apiv1alpha1.Port, error) {
+	uuid := types.UID(lsp.Name)
+
+	portName := string(uuid)
+	if name, ok := lsp.ExternalIDs["neutron:port_name"]; ok && name != "" {
+		portName = name
+	}
+
+	port := &apiv1alpha1.Port{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Port",
+			APIVersion: "atmosphere.vexxhost.com/v1alpha1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   portName,
+			UID:    uuid,
+			Labels: maps.Clone(lsp.ExternalIDs),
+		},
+		Status: apiv1alpha1.PortStatus{
+			InternalUUID: ptr.To(types.UID(lsp.UUID)),
+			NetworkID:    networkID,
+			DeviceOwner:  lsp.ExternalIDs["neutron:device_owner"],
+			DeviceID:     lsp.ExternalIDs["neutron:device_id"],
+			Up:           lsp.Up,
+		},
+	}
+
+	port.Status.MACAddress, port.Status.IPAddresses = parseAddresses(lsp)
+
+	if m.sbClient != nil {
+		chassis, err := BindingChassis(ctx, m.sbClient, lsp.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		port.Status.Chassis = chassis
+	}
+
+	return port, nil
+}
+
+// parseAddresses returns the MAC and IP addresses of a logical switch port,
+// falling back to the `neutron:cidrs` external ID for ports such as router
+// ports which do not list their addresses
+func parseAddresses(lsp *nbdb.LogicalSwitchPort) (string, []string) {
+	var mac string
+	var ips []string
+
+	for _, address := range lsp.Addresses {
+		fields := strings.Fields(address)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if _, err := net.ParseMAC(fields[0]); err != nil {
+			continue
+		}
+
+		if mac == "" {
+			mac = fields[0]
+		}
+		ips = append(ips, fields[1:]...)
+	}
+
+	if len(ips) == 0 {
+		for _, cidr := range strings.Fields(lsp.ExternalIDs["neutron:cidrs"]) {
+			ip, _, _ := strings.Cut(cidr, "/")
+			ips = append(ips, ip)
+		}
+	}
+
+	return mac, ips
+}
+
+// BindingChassis returns the name of the chassis a logical port is bound to, or
+// an empty string if it is not bound, using a southbound client monitoring the
+// tables returned by SouthboundTables
+func BindingChassis(ctx context.Context, sbClient client.Client, logicalPort string) (string, error) {
+	// NOTE: The logical port is an index of the Port_Binding table, so the
+	//       binding is looked up without scanning every row of the cache.
+	bindings := []sbdb.PortBinding{}
+	if err := sbClient.Where(&sbdb.PortBinding{LogicalPort: logicalPort}).List(ctx, &bindings); err != nil {
+		return "", fmt.Errorf("failed to get port binding for port %q: %w", logicalPort, err)
+	}
+
+	if len(bindings) == 0 || bindings[0].Chassis == nil {
+		return "", nil
+	}
+
+	chassis := sbdb.Chassis{UUID: *bindings[0].Chassis}
+	if err := sbClient.Get(ctx, &chassis); err != nil {
+		return "", fmt.Errorf("failed to get chassis %q for port %q: %w", *bindings[0].Chassis, logicalPort, err)
+	}
+
+	return chassis.Name, nil
+}
+
+// getNetworks returns the UUID of the network of every logical switch port
+func (m *Manager) getNetworks(ctx context.Context) (map[string]types.UID, error) {
+	var switches []nbdb.LogicalSwitch
+	if err := m.client.List(ctx, &switches); err != nil {
+		return nil, fmt.Errorf("failed to list networks: %w", err)
+	}
+
+	networks := make(map[string]types.UID)
+	for _, ls := range switches {
+		for _, portUUID := range ls.Ports {
+			networks[portUUID] = types.UID(strings.TrimPrefix(ls.Name, "neutron-"))
+		}
+	}
+
+	return networks, nil
+}
+
+// GetByUUID retrieves a port by its Neutron UUID
+func (m *Manager) GetByUUID(ctx context.Context, uuid types.UID) (*apiv1alpha1.Port, error) {
+	lsps := []nbdb.LogicalSwitchPort{}
+	if err := m.client.Where(&nbdb.LogicalSwitchPort{Name: string(uuid)}).List(ctx, &lsps); err != nil {
+		return nil, fmt.Errorf("failed to get port %q: %w", uuid, err)
+	}
+
+	if len(lsps) == 0 {
+		return nil, fmt.Errorf("port %q not found", uuid)
+	}
+
+	networks, err := m.getNetworks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.convertToPort(ctx, &lsps[0], networks[lsps[0].UUID])
+}
+
+// List retrieves all ports matching the given options
+func (m *Manager) List(ctx context.Context, opts ListOptions) (*apiv1alpha1.PortList, error) {
+	networks, err := m.getNetworks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var lsps []nbdb.LogicalSwitchPort
+	if err := m.client.WhereCache(func(lsp *nbdb.LogicalSwitchPort) bool {
+		// Localnet ports connect provider networks and are not Neutron ports
+		if lsp.Type == "localnet" {
+			return false
+		}
+
+		if opts.NetworkID != "" && networks[lsp.UUID] != opts.NetworkID {
+			return false
+		}
+
+		if opts.DeviceID != "" && lsp.ExternalIDs["neutron:device_id"] != opts.DeviceID {
+			return false
+		}
+
+		return true
+	}).List(ctx, &lsps); err != nil {
+		return nil, err
+	}
+
+	result := &apiv1alpha1.PortList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PortList",
+			APIVersion: "atmosphere.vexxhost.com/v1alpha1",
+		},
+		Items: make([]apiv1alpha1.Port, 0, len(lsps)),
+	}
+
+	for _, lsp := range lsps {
+		port, err := m.convertToPort(ctx, &lsp, networks[lsp.UUID])
+		if err != nil {
+			continue
+		}
+
+		result.Items = append(result.Items, *port)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,184 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovnport
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/sbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	testNetworkUUID  = "5b3c1a2e-7f0d-4c1e-9b8a-2d6f4e3c1a0b"
+	testNetworkUUID2 = "6c4d2b3f-8a1e-4d2f-8c9b-3e7a5f4d2b1c"
+	testPortUUID     = "0f1e2d3c-4b5a-4968-8776-655443322110"
+	testPortUUID2    = "1f2e3d4c-5b6a-4a79-8877-665544332211"
+	testPortUUID3    = "2f3e4d5c-6b7a-4b8a-9988-776655443322"
+	testDeviceUUID   = "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d"
+	testChassisUUID  = "aa3fd293-3f8c-42f9-9d72-4afa984727b3"
+)
+
+func testData() ([]libovsdb.TestData, []libovsdb.TestData) {
+	nbData := []libovsdb.TestData{
+		&nbdb.LogicalSwitchPort{
+			UUID:      "lsp-1",
+			Name:      testPortUUID,
+			Addresses: []string{"fa:16:3e:00:00:01 10.0.0.5 fd00::5"},
+			Up:        ptr.To(true),
+			ExternalIDs: map[string]string{
+				"neutron:port_name":    "vm-port",
+				"neutron:device_owner": "compute:nova",
+				"neutron:device_id":    testDeviceUUID,
+			},
+		},
+		&nbdb.LogicalSwitchPort{
+			UUID:      "lsp-2",
+			Name:      testPortUUID2,
+			Addresses: []string{"router"},
+			ExternalIDs: map[string]string{
+				"neutron:device_owner": "network:router_interface",
+				"neutron:cidrs":        "10.0.0.1/24",
+			},
+		},
+		&nbdb.LogicalSwitchPort{
+			UUID: "lsp-3",
+			Name: testPortUUID3,
+		},
+		&nbdb.LogicalSwitchPort{
+			UUID: "lsp-localnet",
+			Name: "provnet-1",
+			Type: "localnet",
+		},
+		&nbdb.LogicalSwitch{
+			Name:  "neutron-" + testNetworkUUID,
+			Ports: []string{"lsp-1", "lsp-2"},
+		},
+		&nbdb.LogicalSwitch{
+			Name:  "neutron-" + testNetworkUUID2,
+			Ports: []string{"lsp-3", "lsp-localnet"},
+		},
+	}
+
+	sbData := []libovsdb.TestData{
+		&sbdb.Chassis{UUID: testChassisUUID, Name: "compute-1"},
+		&sbdb.PortBinding{LogicalPort: testPortUUID, TunnelKey: 1, Chassis: ptr.To(testChassisUUID)},
+		&sbdb.PortBinding{LogicalPort: testPortUUID2, TunnelKey: 2},
+	}
+
+	return nbData, sbData
+}
+
+func TestList(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     ListOptions
+		expected []types.UID
+	}{
+		{
+			name:     "all ports",
+			expected: []types.UID{testPortUUID, testPortUUID2, testPortUUID3},
+		},
+		{
+			name:     "by network",
+			opts:     ListOptions{NetworkID: testNetworkUUID},
+			expected: []types.UID{testPortUUID, testPortUUID2},
+		},
+		{
+			name:     "by device",
+			opts:     ListOptions{DeviceID: testDeviceUUID},
+			expected: []types.UID{testPortUUID},
+		},
+		{
+			name:     "by network and device",
+			opts:     ListOptions{NetworkID: testNetworkUUID2, DeviceID: testDeviceUUID},
+			expected: []types.UID{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			nbData, _ := testData()
+			nbClient, cleanup, err := libovsdb.NewNBTestHarness(libovsdb.TestSetup{
+				NBData: nbData,
+			}, nil)
+			require.NoError(t, err)
+			t.Cleanup(cleanup.Cleanup)
+
+			manager := NewManager(nbClient)
+			list, err := manager.List(ctx, tt.opts)
+			require.NoError(t, err)
+
+			uuids := []types.UID{}
+			for _, port := range list.Items {
+				uuids = append(uuids, port.UID)
+			}
+			assert.ElementsMatch(t, tt.expected, uuids)
+		})
+	}
+}
+
+func TestGetByUUID(t *testing.T) {
+	tests := []struct {
+		name            string
+		uuid            types.UID
+		expectedName    string
+		expectedNetwork types.UID
+		expectedMAC     string
+		expectedIPs     []string
+		expectedChassis string
+	}{
+		{
+			name:            "bound instance port",
+			uuid:            testPortUUID,
+			expectedName:    "vm-port",
+			expectedNetwork: testNetworkUUID,
+			expectedMAC:     "fa:16:3e:00:00:01",
+			expectedIPs:     []string{"10.0.0.5", "fd00::5"},
+			expectedChassis: "compute-1",
+		},
+		{
+			name:            "unbound router port",
+			uuid:            testPortUUID2,
+			expectedName:    testPortUUID2,
+			expectedNetwork: testNetworkUUID,
+			expectedIPs:     []string{"10.0.0.1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			nbData, sbData := testData()
+			nbClient, sbClient, cleanup, err := libovsdb.NewNBSBTestHarness(libovsdb.TestSetup{
+				NBData: nbData,
+				SBData: sbData,
+			})
+			require.NoError(t, err)
+			t.Cleanup(cleanup.Cleanup)
+
+			manager := NewManager(nbClient, WithSouthbound(sbClient))
+			port, err := manager.GetByUUID(ctx, tt.uuid)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedName, port.Name)
+			assert.Equal(t, tt.expectedNetwork, port.Status.NetworkID)
+			assert.Equal(t, tt.expectedMAC, port.Status.MACAddress)
+			assert.Equal(t, tt.expectedIPs, port.Status.IPAddresses)
+			assert.Equal(t, tt.expectedChassis, port.Status.Chassis)
+		})
+	}
+}
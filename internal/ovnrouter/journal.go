@@ -0,0 +1,141 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovnrouter
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+)
+
+const (
+	// FailoverJournalAPIVersion is the API version of the failover journal format
+	FailoverJournalAPIVersion = "atmosphere.vexxhost.com/v1alpha1"
+
+	// FailoverJournalKind is the kind of the failover journal format
+	FailoverJournalKind = "FailoverJournal"
+)
+
+// NewFailoverJournal creates a journal recording the given failover plans
+func NewFailoverJournal(plans []apiv1alpha1.FailoverPlan) *apiv1alpha1.FailoverJournal {
+	journal := &apiv1alpha1.FailoverJournal{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       FailoverJournalKind,
+			APIVersion: FailoverJournalAPIVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(time.Now().UTC()),
+		},
+		Plans: plans,
+	}
+
+	if journal.Plans == nil {
+		journal.Plans = []apiv1alpha1.FailoverPlan{}
+	}
+
+	return journal
+}
+
+// WriteFailoverJournal writes the journal as JSON to the given path, refusing
+// to overwrite an existing file so that a previous journal is never lost
+func WriteFailoverJournal(path string, journal *apiv1alpha1.FailoverJournal) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create failover journal %q: %w", path, err)
+	}
+
+	return writeFailoverJournal(file, path, journal)
+}
+
+// UpdateFailoverJournal replaces the journal previously written to the given
+// path by WriteFailoverJournal, so that it records the plans which were
+// actually applied. The journal is written to a temporary file first and
+// renamed over the previous one, which is kept intact if anything fails.
+func UpdateFailoverJournal(path string, journal *apiv1alpha1.FailoverJournal) error {
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("failed to update failover journal %q: %w", path, err)
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create failover journal %q: %w", path, err)
+	}
+
+	if err := writeFailoverJournal(file, path, journal); err != nil {
+		os.Remove(file.Name())
+		return err
+	}
+
+	if err := os.Rename(file.Name(), path); err != nil {
+		os.Remove(file.Name())
+		return fmt.Errorf("failed to replace failover journal %q: %w", path, err)
+	}
+
+	return nil
+}
+
+// writeFailoverJournal writes the journal as JSON to the given file and closes
+// it, path is only used in error messages
+func writeFailoverJournal(file *os.File, path string, journal *apiv1alpha1.FailoverJournal) error {
+	data, err := json.MarshalIndent(journal, "", "  ")
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to encode failover journal: %w", err)
+	}
+
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write failover journal %q: %w", path, err)
+	}
+
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to sync failover journal %q: %w", path, err)
+	}
+
+	return file.Close()
+}
+
+// ReadFailoverJournal reads a journal written by WriteFailoverJournal and
+// validates its version
+func ReadFailoverJournal(path string) (*apiv1alpha1.FailoverJournal, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read failover journal %q: %w", path, err)
+	}
+
+	journal := &apiv1alpha1.FailoverJournal{}
+	if err := json.Unmarshal(data, journal); err != nil {
+		return nil, fmt.Errorf("failed to decode failover journal %q: %w", path, err)
+	}
+
+	if journal.Kind != FailoverJournalKind || journal.APIVersion != FailoverJournalAPIVersion {
+		return nil, fmt.Errorf("unsupported failover journal %q: expected %s %s, got %q %q",
+			path, FailoverJournalAPIVersion, FailoverJournalKind, journal.APIVersion, journal.Kind)
+	}
+
+	return journal, nil
+}
+
+// ReverseFailoverPlan returns a plan which restores the original priorities
+// recorded in the given plan and moves the router back to its original chassis
+func ReverseFailoverPlan(plan *apiv1alpha1.FailoverPlan) *apiv1alpha1.FailoverPlan {
+	reverse := plan.DeepCopy()
+	reverse.Spec.Strategy = ""
+	reverse.Spec.CurrentChassis = plan.Spec.TargetChassis
+	reverse.Spec.TargetChassis = plan.Spec.CurrentChassis
+
+	for i := range reverse.Spec.Changes {
+		change := &reverse.Spec.Changes[i]
+		change.OldPriority, change.NewPriority = change.NewPriority, change.OldPriority
+	}
+
+	return reverse
+}
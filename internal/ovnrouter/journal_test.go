@@ -0,0 +1,98 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovnrouter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+)
+
+func testFailoverPlan() apiv1alpha1.FailoverPlan {
+	return apiv1alpha1.FailoverPlan{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "router-1",
+			UID:  testRouterUUID,
+		},
+		Spec: apiv1alpha1.FailoverPlanSpec{
+			Strategy:       string(FailoverStrategySwap),
+			CurrentChassis: "gwc-2",
+			TargetChassis:  "gwc-1",
+			Changes: []apiv1alpha1.FailoverPriorityChange{
+				{Table: nbdb.GatewayChassisTable, UUID: testChassisUUID2, ChassisName: "gwc-2", OldPriority: 2, NewPriority: 1},
+				{Table: nbdb.GatewayChassisTable, UUID: testChassisUUID, ChassisName: "gwc-1", OldPriority: 1, NewPriority: 2},
+			},
+		},
+	}
+}
+
+func TestFailoverJournal_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.json")
+
+	journal := NewFailoverJournal([]apiv1alpha1.FailoverPlan{testFailoverPlan()})
+	require.NoError(t, WriteFailoverJournal(path, journal))
+
+	read, err := ReadFailoverJournal(path)
+	require.NoError(t, err)
+	assert.Equal(t, FailoverJournalKind, read.Kind)
+	assert.Equal(t, FailoverJournalAPIVersion, read.APIVersion)
+	assert.Equal(t, journal.Plans, read.Plans)
+
+	// An existing journal must never be overwritten
+	err = WriteFailoverJournal(path, journal)
+	require.Error(t, err)
+}
+
+func TestFailoverJournal_Update(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "journal.json")
+
+	// Only an existing journal can be updated
+	journal := NewFailoverJournal(nil)
+	require.Error(t, UpdateFailoverJournal(path, journal))
+	require.NoError(t, WriteFailoverJournal(path, journal))
+
+	journal.Plans = []apiv1alpha1.FailoverPlan{testFailoverPlan()}
+	require.NoError(t, UpdateFailoverJournal(path, journal))
+
+	read, err := ReadFailoverJournal(path)
+	require.NoError(t, err)
+	assert.Equal(t, journal.Plans, read.Plans)
+
+	// No temporary file must be left behind
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestFailoverJournal_UnsupportedVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"apiVersion":"atmosphere.vexxhost.com/v2","kind":"FailoverJournal","plans":[]}`), 0o600))
+
+	_, err := ReadFailoverJournal(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported failover journal")
+}
+
+func TestReverseFailoverPlan(t *testing.T) {
+	plan := testFailoverPlan()
+	reverse := ReverseFailoverPlan(&plan)
+
+	assert.Equal(t, "gwc-1", reverse.Spec.CurrentChassis)
+	assert.Equal(t, "gwc-2", reverse.Spec.TargetChassis)
+	assert.Equal(t, []apiv1alpha1.FailoverPriorityChange{
+		{Table: nbdb.GatewayChassisTable, UUID: testChassisUUID2, ChassisName: "gwc-2", OldPriority: 1, NewPriority: 2},
+		{Table: nbdb.GatewayChassisTable, UUID: testChassisUUID, ChassisName: "gwc-1", OldPriority: 2, NewPriority: 1},
+	}, reverse.Spec.Changes)
+
+	// The original plan must be left untouched
+	assert.Equal(t, testFailoverPlan(), plan)
+}
@@ -5,7 +5,9 @@ package ovnrouter
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
 	"slices"
 	"sort"
 	"strings"
@@ -20,18 +22,90 @@ import (
 	"k8s.io/utils/ptr"
 
 	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+	"github.com/vexxhost/atmosphere/internal/ovnport"
 )
 
+// Tables returns the northbound tables which must be monitored by the client
+// used by the Manager
+func Tables() map[string]model.Model {
+	return map[string]model.Model{
+		nbdb.GatewayChassisTable:    &nbdb.GatewayChassis{},
+		nbdb.HAChassisGroupTable:    &nbdb.HAChassisGroup{},
+		nbdb.HAChassisTable:         &nbdb.HAChassis{},
+		nbdb.LogicalRouterPortTable: &nbdb.LogicalRouterPort{},
+		nbdb.LogicalRouterTable:     &nbdb.LogicalRouter{},
+	}
+}
+
+// SouthboundTables returns the southbound tables which must be monitored by the
+// client given to WithSouthbound
+func SouthboundTables() map[string]model.Model {
+	return ovnport.SouthboundTables()
+}
+
+// HealthChecker checks if a chassis is able to host gateway router ports
+type HealthChecker interface {
+	// CheckGatewayChassis returns an error describing why the chassis can not
+	// host gateway router ports, or nil if it can
+	CheckGatewayChassis(ctx context.Context, name string) error
+}
+
 // Manager provides methods for managing OVN routers
 type Manager struct {
-	client client.Client
+	client        client.Client
+	sbClient      client.Client
+	healthChecker HealthChecker
+}
+
+// ManagerOption configures a Manager
+type ManagerOption func(*Manager)
+
+// WithHealthChecker makes the Manager skip chassis which are not healthy when
+// selecting the chassis a router is moved to
+func WithHealthChecker(hc HealthChecker) ManagerOption {
+	return func(m *Manager) {
+		m.healthChecker = hc
+	}
+}
+
+// HasPortStatus returns true if the schema of the connected northbound
+// database has the status column of logical router ports, which was added in
+// OVN 23.09, otherwise the chassis hosting routers can only be found with a
+// southbound client given to WithSouthbound
+func HasPortStatus(c client.Client) bool {
+	return schemaHasPortStatus(c.Schema())
+}
+
+// WithSouthbound makes the Manager find the chassis hosting a router from the
+// binding of its chassisredirect port in the given southbound client, when the
+// hosting chassis is not in the status of the logical router port
+func WithSouthbound(c client.Client) ManagerOption {
+	return func(m *Manager) {
+		m.sbClient = c
+	}
 }
 
 // NewManager creates a new Manager instance with the given OVN client
-func NewManager(c client.Client) *Manager {
-	return &Manager{
+func NewManager(c client.Client, opts ...ManagerOption) *Manager {
+	m := &Manager{
 		client: c,
 	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+// checkGatewayChassis returns an error if a health checker is configured and
+// the chassis can not host gateway router ports
+func (m *Manager) checkGatewayChassis(ctx context.Context, name string) error {
+	if m.healthChecker == nil {
+		return nil
+	}
+
+	return m.healthChecker.CheckGatewayChassis(ctx, name)
 }
 
 func (m *Manager) convertToRouter(ctx context.Context, lr *nbdb.LogicalRouter) (*apiv1alpha1.Router, error) {
@@ -51,23 +125,47 @@ func (m *Manager) convertToRouter(ctx context.Context, lr *nbdb.LogicalRouter) (
 			APIVersion: "atmosphere.vexxhost.com/v1alpha1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name: routerName,
-			UID:  types.UID(uuid),
+			Name:   routerName,
+			UID:    types.UID(uuid),
+			Labels: maps.Clone(lr.ExternalIDs),
 		},
 		Status: apiv1alpha1.RouterStatus{
 			InternalUUID: ptr.To(types.UID(lr.UUID)),
+			Enabled:      ptr.Deref(lr.Enabled, true),
+			NATs:         len(lr.Nat),
+			StaticRoutes: len(lr.StaticRoutes),
 		},
 	}
 
 	for _, portUUID := range lr.Ports {
-		lrp := nbdb.LogicalRouterPort{UUID: portUUID}
-		if err := m.client.Get(ctx, &lrp); err != nil {
+		lrp, err := m.getLogicalRouterPort(ctx, portUUID)
+		if err != nil {
 			return nil, fmt.Errorf("failed to get logical router port %q for router %q: %w", portUUID, lr.Name, err)
 		}
 
 		if lrp.ExternalIDs["neutron:is_ext_gw"] == "True" {
 			router.Status.ExternalIPs = append(router.Status.ExternalIPs, lrp.Networks...)
-			router.Status.Agent = lrp.Status["hosting-chassis"]
+
+			// NOTE: The hosting chassis is only informational here, so a
+			//       failure to look it up in the southbound database leaves
+			//       the agent empty rather than dropping the router.
+			agent, err := m.getPortHostingChassis(ctx, lrp)
+			if err != nil {
+				agent = ""
+			}
+			router.Status.Agent = agent
+
+			set, err := m.getPortGatewayChassisSet(ctx, lrp)
+			if err != nil {
+				return nil, err
+			}
+
+			for i := len(set.Members) - 1; i >= 0; i-- {
+				router.Status.GatewayChassis = append(router.Status.GatewayChassis, apiv1alpha1.RouterGatewayChassis{
+					Name:     set.Members[i].ChassisName,
+					Priority: set.Members[i].Priority,
+				})
+			}
 		}
 
 		router.Status.Ports = append(router.Status.Ports, apiv1alpha1.RouterPortInfo{
@@ -82,8 +180,14 @@ func (m *Manager) convertToRouter(ctx context.Context, lr *nbdb.LogicalRouter) (
 
 // GetByUUID retrieves a router by its UUID
 func (m *Manager) GetByUUID(ctx context.Context, uuid types.UID) (*apiv1alpha1.Router, error) {
+	// NOTE: The name of logical routers is not an index of the schema, so it
+	//       can not be used with Where unless the client was created with a
+	//       client index for it.
+	name := fmt.Sprintf("neutron-%s", uuid)
 	lrs := []nbdb.LogicalRouter{}
-	if err := m.client.Where(&nbdb.LogicalRouter{Name: fmt.Sprintf("neutron-%s", uuid)}).List(ctx, &lrs); err != nil {
+	if err := m.client.WhereCache(func(lr *nbdb.LogicalRouter) bool {
+		return lr.Name == name
+	}).List(ctx, &lrs); err != nil {
 		return nil, fmt.Errorf("failed to get router %q: %w", uuid, err)
 	}
 
@@ -121,6 +225,44 @@ func (m *Manager) List(ctx context.Context) (*apiv1alpha1.RouterList, error) {
 	return result, nil
 }
 
+// ListByHostingChassis retrieves all routers whose gateway port is currently
+// hosted on the given chassis
+func (m *Manager) ListByHostingChassis(ctx context.Context, chassis string) (*apiv1alpha1.RouterList, error) {
+	result, err := m.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]apiv1alpha1.Router, 0, len(result.Items))
+	for _, router := range result.Items {
+		if router.Status.Agent == chassis {
+			items = append(items, router)
+		}
+	}
+	result.Items = items
+
+	return result, nil
+}
+
+// getPortHostingChassis returns the name of the chassis hosting a gateway
+// router port, or an empty string if it is not hosted on any chassis
+func (m *Manager) getPortHostingChassis(ctx context.Context, lrp *nbdb.LogicalRouterPort) (string, error) {
+	if HasPortStatus(m.client) {
+		if chassis, ok := lrp.Status["hosting-chassis"]; ok {
+			return chassis, nil
+		}
+	}
+
+	// NOTE: Before the status column was added, the hosting chassis is only
+	//       known from the binding of the chassisredirect port created by
+	//       ovn-northd for the gateway port.
+	if m.sbClient == nil {
+		return "", nil
+	}
+
+	return ovnport.BindingChassis(ctx, m.sbClient, "cr-"+lrp.Name)
+}
+
 // GetHostingAgent retrieves the name of the agent hosting the router
 func (m *Manager) GetHostingAgent(ctx context.Context, router *apiv1alpha1.Router) (string, error) {
 	var agent string
@@ -130,14 +272,18 @@ func (m *Manager) GetHostingAgent(ctx context.Context, router *apiv1alpha1.Route
 			continue
 		}
 
-		lrp := nbdb.LogicalRouterPort{UUID: string(*port.InternalUUID)}
-		if err := m.client.Get(ctx, &lrp); err != nil {
+		lrp, err := m.getLogicalRouterPort(ctx, string(*port.InternalUUID))
+		if err != nil {
 			return "", fmt.Errorf("failed to get logical router port %q for router %q: %w", port.UUID, router.UID, err)
 		}
 
-		agentChassis, ok := lrp.Status["hosting-chassis"]
-		if !ok {
-			return "", fmt.Errorf("no hosting-chassis found in status for logical router port %q", lrp.UUID)
+		agentChassis, err := m.getPortHostingChassis(ctx, lrp)
+		if err != nil {
+			return "", err
+		}
+
+		if agentChassis == "" {
+			return "", fmt.Errorf("no hosting-chassis found for logical router port %q", lrp.UUID)
 		}
 
 		if agent == "" {
@@ -154,164 +300,561 @@ func (m *Manager) GetHostingAgent(ctx context.Context, router *apiv1alpha1.Route
 	return agent, nil
 }
 
+// FailoverStrategy defines how the priorities of the gateway set of a router
+// are rewritten during a failover
+type FailoverStrategy string
+
+const (
+	// FailoverStrategySwap swaps the priorities of the active chassis and the
+	// chassis which will become active
+	FailoverStrategySwap FailoverStrategy = "swap"
+
+	// FailoverStrategyRotate moves the active chassis to the lowest priority and
+	// shifts every other chassis up by one, preserving their relative order
+	FailoverStrategyRotate FailoverStrategy = "rotate"
+
+	// FailoverStrategyDemote only lowers the priority of the active chassis
+	// below every other chassis, preserving the relative order of the rest
+	FailoverStrategyDemote FailoverStrategy = "demote"
+)
+
+// FailoverStrategies is the list of all supported failover strategies
+var FailoverStrategies = []FailoverStrategy{
+	FailoverStrategySwap,
+	FailoverStrategyRotate,
+	FailoverStrategyDemote,
+}
+
+// Validate returns an error if the strategy is not supported
+func (s FailoverStrategy) Validate() error {
+	if s == "" || slices.Contains(FailoverStrategies, s) {
+		return nil
+	}
+
+	return fmt.Errorf("unknown failover strategy %q, must be one of: %v", s, FailoverStrategies)
+}
+
+// minGatewayChassisPriority is the lowest priority allowed by the schema for
+// the HA_Chassis and Gateway_Chassis tables
+const minGatewayChassisPriority = 0
+
+// FailoverOptions controls how a router failover is performed
+type FailoverOptions struct {
+	// TargetChassis is the name of the chassis which should become active for
+	// the router. When empty, the chassis selected by the strategy is promoted.
+	TargetChassis string
+
+	// Strategy is the strategy used to rewrite the priorities, defaults to
+	// FailoverStrategySwap when empty.
+	Strategy FailoverStrategy
+
+	// Retries is the number of times the plan is recomputed and applied again
+	// when the priorities were changed concurrently since they were read.
+	Retries int
+}
+
+// ErrFailoverConflict is returned when the priorities of the gateway chassis of
+// a router changed between computing a failover plan and applying it
+var ErrFailoverConflict = errors.New("gateway chassis priorities changed concurrently")
+
+// conflictRetryInterval is the time to wait before recomputing a failover plan
+// after a conflict, giving the cache a chance to catch up with the changes
+const conflictRetryInterval = 500 * time.Millisecond
+
+// gatewayChassisMember is a chassis which is a member of the gateway set of a
+// router, regardless of whether it is backed by an HA_Chassis or a
+// Gateway_Chassis row
+type gatewayChassisMember struct {
+	UUID        string
+	ChassisName string
+	Priority    int
+}
+
+// gatewayChassisSet holds the members of the gateway set of a router sorted by
+// priority from the lowest to the highest
+type gatewayChassisSet struct {
+	// HAChassisGroup indicates if the members are HA_Chassis rows, otherwise
+	// they are Gateway_Chassis rows
+	HAChassisGroup bool
+	Members        []gatewayChassisMember
+}
+
+// kind returns a human readable name for the type of members in the set
+func (s *gatewayChassisSet) kind() string {
+	if s.HAChassisGroup {
+		return "HA chassis"
+	}
+
+	return "gateway chassis"
+}
+
+// table returns the name of the OVN table of the members in the set
+func (s *gatewayChassisSet) table() string {
+	if s.HAChassisGroup {
+		return nbdb.HAChassisTable
+	}
+
+	return nbdb.GatewayChassisTable
+}
+
+// active returns the highest priority member which is expected to be active
+func (s *gatewayChassisSet) active() *gatewayChassisMember {
+	return &s.Members[len(s.Members)-1]
+}
+
+// find returns the member for the given chassis name
+func (s *gatewayChassisSet) find(chassisName string) *gatewayChassisMember {
+	for i := range s.Members {
+		if s.Members[i].ChassisName == chassisName {
+			return &s.Members[i]
+		}
+	}
+
+	return nil
+}
+
+// getGatewayPort retrieves the logical router port used as the gateway of the router
+func (m *Manager) getGatewayPort(ctx context.Context, router *apiv1alpha1.Router) (*nbdb.LogicalRouterPort, error) {
+	var gatewayPortInfo *apiv1alpha1.RouterPortInfo
+	for _, port := range router.Status.Ports {
+		if port.IsGateway {
+			gatewayPortInfo = &port
+			break
+		}
+	}
+
+	if gatewayPortInfo == nil {
+		return nil, fmt.Errorf("no gateway chassis found for router %q, router has no gateway port", router.UID)
+	}
+
+	lrp, err := m.getLogicalRouterPort(ctx, string(*gatewayPortInfo.InternalUUID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get logical router port %q for router %q: %w", gatewayPortInfo.UUID, router.UID, err)
+	}
+
+	return lrp, nil
+}
+
+// getGatewayChassisSet retrieves the HA chassis or gateway chassis members of
+// the gateway port of the router
+func (m *Manager) getGatewayChassisSet(ctx context.Context, router *apiv1alpha1.Router) (*gatewayChassisSet, error) {
+	lrp, err := m.getGatewayPort(ctx, router)
+	if err != nil {
+		return nil, err
+	}
+
+	if lrp.HaChassisGroup == nil && len(lrp.GatewayChassis) == 0 {
+		return nil, fmt.Errorf("no gateway chassis found for router %q, logical router port %q has neither gateway chassis nor HA chassis group configured", router.UID, lrp.UUID)
+	}
+
+	set, err := m.getPortGatewayChassisSet(ctx, lrp)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(set.Members) == 0 {
+		return nil, fmt.Errorf("no %s found for router %q", set.kind(), router.UID)
+	}
+
+	return set, nil
+}
+
+// getPortGatewayChassisSet retrieves the HA chassis or gateway chassis members
+// of a gateway port, the set has no members if neither are configured
+func (m *Manager) getPortGatewayChassisSet(ctx context.Context, lrp *nbdb.LogicalRouterPort) (*gatewayChassisSet, error) {
+	set := &gatewayChassisSet{}
+
+	if lrp.HaChassisGroup != nil {
+		set.HAChassisGroup = true
+
+		haChassisGroup := nbdb.HAChassisGroup{UUID: *lrp.HaChassisGroup}
+		if err := m.client.Get(ctx, &haChassisGroup); err != nil {
+			return nil, fmt.Errorf("failed to get HA chassis group %q for logical router port %q: %w", *lrp.HaChassisGroup, lrp.UUID, err)
+		}
+
+		haChassis := []nbdb.HAChassis{}
+		if err := m.client.WhereCache(func(hc *nbdb.HAChassis) bool {
+			return slices.Contains(haChassisGroup.HaChassis, hc.UUID)
+		}).List(ctx, &haChassis); err != nil {
+			return nil, fmt.Errorf("failed to list HA chassis for HA chassis group %q: %w", haChassisGroup.UUID, err)
+		}
+
+		for _, hc := range haChassis {
+			set.Members = append(set.Members, gatewayChassisMember{
+				UUID:        hc.UUID,
+				ChassisName: hc.ChassisName,
+				Priority:    hc.Priority,
+			})
+		}
+	} else if len(lrp.GatewayChassis) != 0 {
+		gcs := []nbdb.GatewayChassis{}
+		if err := m.client.WhereCache(func(gc *nbdb.GatewayChassis) bool {
+			return slices.Contains(lrp.GatewayChassis, gc.UUID)
+		}).List(ctx, &gcs); err != nil {
+			return nil, fmt.Errorf("failed to list gateway chassis for logical router port %q: %w", lrp.UUID, err)
+		}
+
+		for _, gc := range gcs {
+			set.Members = append(set.Members, gatewayChassisMember{
+				UUID:        gc.UUID,
+				ChassisName: gc.ChassisName,
+				Priority:    gc.Priority,
+			})
+		}
+	}
+
+	// Sort the members by priority from lowest to the highest
+	sort.Slice(set.Members, func(i, j int) bool {
+		return set.Members[i].Priority < set.Members[j].Priority
+	})
+
+	return set, nil
+}
+
 // Failover triggers a failover of the router from its current hosting gateway chassis
-// to the next available one by swapping priorities between the highest and lowest.
+// to another member of its gateway set by rewriting the priorities according to the
+// strategy in opts.
 //
-// The failover mechanism swaps the highest priority (currently active) gateway chassis
-// with the lowest priority gateway chassis. This simple approach works well for
-// individual router failovers.
+// The default swap strategy swaps the highest priority (currently active) gateway
+// chassis with the lowest priority gateway chassis. This simple approach works well
+// for individual router failovers.
 //
-// After updating the priorities, the function waits for OVN to actually move the router
-// to the new hosting chassis. The function polls every 500ms until the router is hosted
-// on the expected chassis. The caller must provide a context with an appropriate deadline
-// to prevent indefinite waiting.
+// Example with 3 gateway chassis:
+//
+//	Initial: A(priority=3, active), B(priority=2), C(priority=1)
+//	After failover: C(priority=3, active), B(priority=2), A(priority=1)
 //
-// Note: When draining multiple nodes sequentially in a 3-node cluster, this approach
+// When draining multiple nodes sequentially in a 3-node cluster, the swap strategy
 // may cause some routers to failover twice. For example:
 //   - Initial: A=3 (active), B=2, C=1
 //   - Drain A: C=3 (active), B=2, A=1 (swap A↔C)
 //   - Drain B: No change (B not highest)
 //   - Drain C: A=3 (active), B=2, C=1 (swap C↔A, router back on A)
 //
-// For optimal sequential node draining, a controller-aware orchestration layer
-// should coordinate failovers to minimize total router movements.
-//
-// Example with 3 gateway chassis:
+// The rotate strategy avoids this by moving the active chassis to the lowest
+// priority and shifting every other chassis up by one, so the drained chassis
+// is always the last candidate:
 //
 //	Initial: A(priority=3, active), B(priority=2), C(priority=1)
-//	After failover: C(priority=3, active), B(priority=2), A(priority=1)
+//	After failover: B(priority=3, active), C(priority=2), A(priority=1)
+//
+// The demote strategy results in the same ordering as rotate but only lowers
+// the priority of the active chassis, touching a single row. It falls back to
+// rotate when the lowest priority is already at the minimum allowed value.
+//
+// When opts.TargetChassis is set, or the chassis selected by the strategy is not
+// the second highest because the ones above it are not healthy, the selected
+// chassis becomes the highest priority instead: swap exchanges it with the active
+// chassis, rotate moves it to the top and the active chassis to the bottom, and
+// demote gives it the priority of the active chassis before lowering the latter.
+// An error is returned if the target chassis is not a member of the gateway set
+// of the router.
+//
+// After updating the priorities, the function waits for OVN to actually move the router
+// to the new hosting chassis. The function polls every 500ms until the router is hosted
+// on the expected chassis. The caller must provide a context with an appropriate deadline
+// to prevent indefinite waiting.
 //
 // The function requires at least 2 gateway chassis to perform a failover.
 // Returns an error if no gateway chassis are found or if only one exists.
-func (m *Manager) Failover(ctx context.Context, router *apiv1alpha1.Router) error {
-	var gatewayPortInfo *apiv1alpha1.RouterPortInfo
-	for _, port := range router.Status.Ports {
-		if port.IsGateway {
-			gatewayPortInfo = &port
-			break
-		}
+func (m *Manager) Failover(ctx context.Context, router *apiv1alpha1.Router, opts FailoverOptions) error {
+	plan, err := m.PlanFailover(ctx, router, opts)
+	if err != nil {
+		return err
 	}
 
-	lrp := nbdb.LogicalRouterPort{UUID: string(*gatewayPortInfo.InternalUUID)}
-	if err := m.client.Get(ctx, &lrp); err != nil {
-		return fmt.Errorf("failed to get logical router port %q for router %q: %w", gatewayPortInfo.UUID, router.UID, err)
+	_, err = m.ApplyFailoverPlan(ctx, router, plan, opts)
+	return err
+}
+
+// PlanFailover computes the priority changes a failover of the router would make
+// without applying them. See Failover for details on how the changes are computed.
+func (m *Manager) PlanFailover(ctx context.Context, router *apiv1alpha1.Router, opts FailoverOptions) (*apiv1alpha1.FailoverPlan, error) {
+	if err := opts.Strategy.Validate(); err != nil {
+		return nil, err
 	}
 
-	var updates []model.Model
-	var expectedHost string
+	strategy := opts.Strategy
+	if strategy == "" {
+		strategy = FailoverStrategySwap
+	}
 
-	if lrp.HaChassisGroup != nil {
-		haChassisGroup := nbdb.HAChassisGroup{UUID: *lrp.HaChassisGroup}
-		if err := m.client.Get(ctx, &haChassisGroup); err != nil {
-			return fmt.Errorf("failed to get HA chassis group %q for logical router port %q: %w", lrp.HaChassisGroup, lrp.UUID, err)
+	set, err := m.getGatewayChassisSet(ctx, router)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(set.Members) == 1 {
+		return nil, fmt.Errorf("only one %s found for router %q, cannot failover", set.kind(), router.UID)
+	}
+
+	// The `current` member is the one with the highest priority which is currently active
+	current := set.active()
+
+	// The `next` member is the one which will become active, which is either the
+	// requested target or the one selected by the strategy
+	var next *gatewayChassisMember
+	if opts.TargetChassis != "" {
+		next = set.find(opts.TargetChassis)
+		if next == nil {
+			return nil, fmt.Errorf("chassis %q is not a member of the %s for router %q", opts.TargetChassis, set.kind(), router.UID)
 		}
 
-		haChassis := []nbdb.HAChassis{}
-		if err := m.client.WhereCache(func(hc *nbdb.HAChassis) bool {
-			return slices.Contains(haChassisGroup.HaChassis, hc.UUID)
-		}).List(ctx, &haChassis); err != nil {
-			return fmt.Errorf("failed to list HA chassis for HA chassis group %q: %w", haChassisGroup.UUID, err)
+		if next.UUID != current.UUID {
+			if err := m.checkGatewayChassis(ctx, next.ChassisName); err != nil {
+				return nil, fmt.Errorf("chassis %q can not host router %q: %w", next.ChassisName, router.UID, err)
+			}
+		}
+	} else {
+		// List the candidates in order of preference of the strategy, the swap
+		// strategy prefers the lowest priority while the others prefer the
+		// highest priority after the active one
+		candidates := make([]*gatewayChassisMember, 0, len(set.Members)-1)
+		for i := range set.Members[:len(set.Members)-1] {
+			candidates = append(candidates, &set.Members[i])
+		}
+		if strategy == FailoverStrategyRotate || strategy == FailoverStrategyDemote {
+			slices.Reverse(candidates)
 		}
 
-		if len(haChassis) == 0 {
-			return fmt.Errorf("no HA chassis found for router %q", router.UID)
+		var reasons []string
+		for _, candidate := range candidates {
+			if candidate.UUID == current.UUID {
+				continue
+			}
+
+			if err := m.checkGatewayChassis(ctx, candidate.ChassisName); err != nil {
+				reasons = append(reasons, err.Error())
+				continue
+			}
+
+			next = candidate
+			break
 		}
 
-		if len(haChassis) == 1 {
-			return fmt.Errorf("only one HA chassis found for router %q, cannot failover", router.UID)
+		if next == nil && len(reasons) > 0 {
+			return nil, fmt.Errorf("no healthy %s found for router %q: %s", set.kind(), router.UID, strings.Join(reasons, "; "))
 		}
 
-		sort.Slice(haChassis, func(i, j int) bool {
-			return haChassis[i].Priority < haChassis[j].Priority
+		if next == nil {
+			return nil, fmt.Errorf("unable to determine %s to swap for router %q", set.kind(), router.UID)
+		}
+	}
+
+	currentChassis := router.Status.Agent
+	if currentChassis == "" {
+		currentChassis = current.ChassisName
+	}
+
+	plan := &apiv1alpha1.FailoverPlan{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "FailoverPlan",
+			APIVersion: "atmosphere.vexxhost.com/v1alpha1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: router.Name,
+			UID:  router.UID,
+		},
+		Spec: apiv1alpha1.FailoverPlanSpec{
+			Strategy:       string(strategy),
+			CurrentChassis: currentChassis,
+			TargetChassis:  next.ChassisName,
+		},
+	}
+
+	// The target chassis is already the highest priority, so there are no
+	// priorities to change
+	if next.UUID == current.UUID {
+		return plan, nil
+	}
+
+	priorities := set.priorities(strategy, current, next)
+
+	// List the changes from the highest to the lowest original priority
+	for i := len(set.Members) - 1; i >= 0; i-- {
+		member := set.Members[i]
+
+		priority, ok := priorities[member.UUID]
+		if !ok {
+			continue
+		}
+
+		plan.Spec.Changes = append(plan.Spec.Changes, apiv1alpha1.FailoverPriorityChange{
+			Table:       set.table(),
+			UUID:        member.UUID,
+			ChassisName: member.ChassisName,
+			OldPriority: member.Priority,
+			NewPriority: priority,
 		})
+	}
 
-		// The `nextHaChassis` is the one with the lowest priority which will become active
-		// The `currentHaChassis` is the one with the highest priority which is currently active
-		nextHaChassis := &haChassis[0]
-		currentHaChassis := &haChassis[len(haChassis)-1]
+	return plan, nil
+}
 
-		if nextHaChassis.UUID == currentHaChassis.UUID {
-			return fmt.Errorf("unable to determine HA chassis to swap for router %q", router.UID)
+// ApplyFailoverPlan applies the priority changes of a plan in a single transaction
+// and waits for the router to be hosted on the target chassis of the plan.
+//
+// The transaction is guarded by OVSDB wait operations which make it abort when the
+// priority of any changed row differs from the one recorded in the plan, so that
+// concurrent changes by Neutron or another operator are never clobbered. When that
+// happens, the plan is recomputed from opts and applied again up to opts.Retries
+// times, after which ErrFailoverConflict is returned.
+//
+// The plan which was transacted last is returned, even if waiting for the router
+// fails, since it differs from the given one when it was recomputed. It is nil
+// when no priorities were changed because of a conflict.
+func (m *Manager) ApplyFailoverPlan(ctx context.Context, router *apiv1alpha1.Router, plan *apiv1alpha1.FailoverPlan, opts FailoverOptions) (*apiv1alpha1.FailoverPlan, error) {
+	for attempt := 0; ; attempt++ {
+		err := m.updatePriorities(ctx, plan.Spec.Changes)
+		if err == nil {
+			break
 		}
 
-		updates = []model.Model{
-			&nbdb.HAChassis{
-				UUID:     currentHaChassis.UUID,
-				Priority: nextHaChassis.Priority,
-			},
-			&nbdb.HAChassis{
-				UUID:     nextHaChassis.UUID,
-				Priority: currentHaChassis.Priority,
-			},
+		if !errors.Is(err, ErrFailoverConflict) {
+			return plan, err
 		}
 
-		expectedHost = nextHaChassis.ChassisName
-	} else if len(lrp.GatewayChassis) != 0 {
-		gcs := []nbdb.GatewayChassis{}
-		if err := m.client.WhereCache(func(gc *nbdb.GatewayChassis) bool {
-			return slices.Contains(lrp.GatewayChassis, gc.UUID)
-		}).List(ctx, &gcs); err != nil {
-			return fmt.Errorf("failed to list gateway chassis for logical router port %q: %w", lrp.UUID, err)
+		if attempt >= opts.Retries {
+			return nil, err
 		}
 
-		if len(gcs) == 0 {
-			return fmt.Errorf("no gateway chassis found for router %q", router.UID)
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("failed retrying failover of router %q: %w", router.UID, ctx.Err())
+		case <-time.After(conflictRetryInterval):
 		}
 
-		if len(gcs) == 1 {
-			return fmt.Errorf("only one gateway chassis found for router %q, cannot failover", router.UID)
+		plan, err = m.PlanFailover(ctx, router, opts)
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		// Sort the gateway chassis by priority from lowest to the highest
-		sort.Slice(gcs, func(i, j int) bool {
-			return gcs[i].Priority < gcs[j].Priority
-		})
+	return plan, m.waitForHostingChassis(ctx, router, plan.Spec.TargetChassis)
+}
+
+// priorities computes the new priorities of the members which need to change
+// so that next becomes the highest priority member according to the strategy
+func (s *gatewayChassisSet) priorities(strategy FailoverStrategy, current, next *gatewayChassisMember) map[string]int {
+	switch strategy {
+	case FailoverStrategyDemote:
+		// NOTE: When the next member is not the second highest, because it was
+		//       requested or the ones above it are not healthy, it also takes
+		//       the priority freed by the active member.
+		if s.Members[0].Priority > minGatewayChassisPriority {
+			priorities := map[string]int{current.UUID: s.Members[0].Priority - 1}
+			if next != &s.Members[len(s.Members)-2] {
+				priorities[next.UUID] = current.Priority
+			}
 
-		// The `nextGC` is the one with the lowest priority which will become active
-		// The `currentGC` is the one with the highest priority which is currently active
-		nextGC := &gcs[0]
-		currentGC := &gcs[len(gcs)-1]
+			return priorities
+		}
 
-		if nextGC.UUID == currentGC.UUID {
-			return fmt.Errorf("unable to determine gateway chassis to swap for router %q", router.UID)
+		return s.priorities(FailoverStrategyRotate, current, next)
+	case FailoverStrategyRotate:
+		// Build the new order from the lowest to the highest priority by moving
+		// the active member to the bottom and the next member to the top
+		order := make([]*gatewayChassisMember, 0, len(s.Members))
+		order = append(order, current)
+		for i := range s.Members {
+			if &s.Members[i] != current && &s.Members[i] != next {
+				order = append(order, &s.Members[i])
+			}
 		}
+		order = append(order, next)
 
-		// Swap priorities between the current active and the next one
-		updates = []model.Model{
-			&nbdb.GatewayChassis{
-				UUID:     currentGC.UUID,
-				Priority: nextGC.Priority,
-			},
-			&nbdb.GatewayChassis{
-				UUID:     nextGC.UUID,
-				Priority: currentGC.Priority,
-			},
+		// Re-assign the existing priority values following the new order
+		priorities := map[string]int{}
+		for i, member := range order {
+			if member.Priority != s.Members[i].Priority {
+				priorities[member.UUID] = s.Members[i].Priority
+			}
 		}
 
-		expectedHost = nextGC.ChassisName
-	} else {
-		return fmt.Errorf("logical router port %q has neither gateway chassis nor HA chassis group configured", lrp.UUID)
+		return priorities
+	default:
+		return map[string]int{
+			current.UUID: next.Priority,
+			next.UUID:    current.Priority,
+		}
+	}
+}
+
+// updatePriorities updates the priorities of HA chassis or gateway chassis rows
+// in a single transaction, provided that every row still has its old priority
+func (m *Manager) updatePriorities(ctx context.Context, changes []apiv1alpha1.FailoverPriorityChange) error {
+	if len(changes) == 0 {
+		return nil
 	}
 
-	var operations []ovsdb.Operation
-	for _, update := range updates {
-		ops, err := m.client.Where(update).Update(update)
+	var waits, updates []ovsdb.Operation
+	for _, change := range changes {
+		// NOTE: The priority field is passed explicitly since libovsdb skips
+		//       fields with default values, which would drop a priority of 0.
+		var current, update model.Model
+		var currentField, updateField interface{}
+		switch change.Table {
+		case nbdb.HAChassisTable:
+			hc := &nbdb.HAChassis{UUID: change.UUID, Priority: change.OldPriority}
+			current, currentField = hc, &hc.Priority
+			hc = &nbdb.HAChassis{UUID: change.UUID, Priority: change.NewPriority}
+			update, updateField = hc, &hc.Priority
+		case nbdb.GatewayChassisTable:
+			gc := &nbdb.GatewayChassis{UUID: change.UUID, Priority: change.OldPriority}
+			current, currentField = gc, &gc.Priority
+			gc = &nbdb.GatewayChassis{UUID: change.UUID, Priority: change.NewPriority}
+			update, updateField = gc, &gc.Priority
+		default:
+			return fmt.Errorf("unsupported table %q for priority update", change.Table)
+		}
+
+		// Abort the transaction immediately if the row no longer has the
+		// priority it had when the plan was computed
+		ops, err := m.client.Where(current).Wait(ovsdb.WaitConditionEqual, ptr.To(0), current, currentField)
 		if err != nil {
-			return fmt.Errorf("failed to prepare update for %q: %w", update, err)
+			return fmt.Errorf("failed to prepare wait for %q: %w", current, err)
 		}
+		waits = append(waits, ops...)
 
-		operations = append(operations, ops...)
+		ops, err = m.client.Where(update).Update(update, updateField)
+		if err != nil {
+			return fmt.Errorf("failed to prepare update for %q: %w", update, err)
+		}
+		updates = append(updates, ops...)
 	}
 
+	operations := append(waits, updates...)
+
 	results, err := m.client.Transact(ctx, operations...)
 	if err != nil {
 		return fmt.Errorf("failed to update priorities: %w", err)
 	}
 
-	if _, err := ovsdb.CheckOperationResults(results, operations); err != nil {
+	if opErrors, err := ovsdb.CheckOperationResults(results, operations); err != nil {
+		// The wait operations come first, with one operation per change
+		for _, opError := range opErrors {
+			var timedOut *ovsdb.TimedOut
+			if !errors.As(opError, &timedOut) {
+				continue
+			}
+
+			for i, change := range changes {
+				if opError.Operation() == &operations[i] {
+					return fmt.Errorf("%w: %s %q for chassis %q no longer has priority %d",
+						ErrFailoverConflict, change.Table, change.UUID, change.ChassisName, change.OldPriority)
+				}
+			}
+		}
+
 		return err
 	}
 
+	return nil
+}
+
+// waitForHostingChassis polls until the router is hosted on the expected chassis
+// or the context is done
+func (m *Manager) waitForHostingChassis(ctx context.Context, router *apiv1alpha1.Router, expectedHost string) error {
 	ticker := time.NewTicker(500 * time.Millisecond)
 	defer ticker.Stop()
 
@@ -321,7 +864,6 @@ func (m *Manager) Failover(ctx context.Context, router *apiv1alpha1.Router) erro
 			return fmt.Errorf("failed waiting for router %q to failover to %q: %w", router.UID, expectedHost, ctx.Err())
 		case <-ticker.C:
 			currentHost, err := m.GetHostingAgent(ctx, router)
-			fmt.Println(currentHost)
 			if err != nil {
 				continue
 			}
@@ -5,6 +5,8 @@ package ovnrouter
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"testing"
 	"time"
 
@@ -12,10 +14,14 @@ import (
 	"github.com/ovn-org/libovsdb/client"
 	"github.com/ovn-org/libovsdb/model"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/sbdb"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
 )
 
 const (
@@ -26,6 +32,7 @@ const (
 	testChassisUUID  = "aa3fd293-3f8c-42f9-9d72-4afa984727b3"
 	testChassisUUID2 = "bb4fd293-3f8c-42f9-9d72-4afa984727b3"
 	testChassisUUID3 = "cc4fd293-3f8c-42f9-9d72-4afa984727b3"
+	testGroupUUID    = "dd4fd293-3f8c-42f9-9d72-4afa984727b3"
 )
 
 func setupTestHarnessForTest(t *testing.T, nbData []libovsdb.TestData) (client.Client, *libovsdb.Context) {
@@ -38,20 +45,35 @@ func setupTestHarnessForTest(t *testing.T, nbData []libovsdb.TestData) (client.C
 
 	// Helper function to update logical router port status based on gateway chassis priorities
 	updateLogicalRouterPortStatus := func(ctx context.Context, lrp *nbdb.LogicalRouterPort) {
-		var selected *nbdb.GatewayChassis
+		agentName := ""
+		priority := -1
+
 		for _, gcUUID := range lrp.GatewayChassis {
 			gc := nbdb.GatewayChassis{UUID: gcUUID}
 			err := nbClient.Get(ctx, &gc)
 			require.NoError(t, err)
 
-			if selected == nil || gc.Priority > selected.Priority {
-				selected = &gc
+			if gc.Priority > priority {
+				agentName = gc.ChassisName
+				priority = gc.Priority
 			}
 		}
 
-		agentName := ""
-		if selected != nil {
-			agentName = selected.ChassisName
+		if lrp.HaChassisGroup != nil {
+			hcg := nbdb.HAChassisGroup{UUID: *lrp.HaChassisGroup}
+			err := nbClient.Get(ctx, &hcg)
+			require.NoError(t, err)
+
+			for _, hcUUID := range hcg.HaChassis {
+				hc := nbdb.HAChassis{UUID: hcUUID}
+				err := nbClient.Get(ctx, &hc)
+				require.NoError(t, err)
+
+				if hc.Priority > priority {
+					agentName = hc.ChassisName
+					priority = hc.Priority
+				}
+			}
 		}
 
 		lrp.Status = map[string]string{
@@ -101,6 +123,32 @@ func setupTestHarnessForTest(t *testing.T, nbData []libovsdb.TestData) (client.C
 					updateLogicalRouterPortStatus(ctx, &lrp)
 				}
 			}
+
+			if table == nbdb.HAChassisTable {
+				hc := newModel.(*nbdb.HAChassis)
+
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+
+				var lrps []nbdb.LogicalRouterPort
+				err := nbClient.WhereCache(func(lrp *nbdb.LogicalRouterPort) bool {
+					if lrp.HaChassisGroup == nil {
+						return false
+					}
+
+					hcg := nbdb.HAChassisGroup{UUID: *lrp.HaChassisGroup}
+					if err := nbClient.Get(ctx, &hcg); err != nil {
+						return false
+					}
+
+					return slices.Contains(hcg.HaChassis, hc.UUID)
+				}).List(ctx, &lrps)
+				require.NoError(t, err)
+
+				for _, lrp := range lrps {
+					updateLogicalRouterPortStatus(ctx, &lrp)
+				}
+			}
 		},
 	})
 
@@ -161,6 +209,229 @@ func TestList(t *testing.T) {
 	}
 }
 
+func TestRouter_Status(t *testing.T) {
+	tests := []struct {
+		name     string
+		nbData   []libovsdb.TestData
+		expected apiv1alpha1.RouterStatus
+	}{
+		{
+			name: "router without gateway",
+			nbData: []libovsdb.TestData{
+				&nbdb.LogicalRouter{
+					Name: "neutron-" + testRouterUUID,
+				},
+			},
+			expected: apiv1alpha1.RouterStatus{
+				Enabled: true,
+			},
+		},
+		{
+			name: "disabled router with gateway chassis",
+			nbData: []libovsdb.TestData{
+				&nbdb.GatewayChassis{UUID: "gc-1", Name: "gc-1", ChassisName: "network-1", Priority: 1},
+				&nbdb.GatewayChassis{UUID: "gc-2", Name: "gc-2", ChassisName: "network-2", Priority: 2},
+				&nbdb.LogicalRouterPort{
+					UUID:           "lrp-gw",
+					Name:           "lrp-" + testPortUUID1,
+					Networks:       []string{"203.0.113.5/24"},
+					ExternalIDs:    map[string]string{"neutron:is_ext_gw": "True"},
+					GatewayChassis: []string{"gc-1", "gc-2"},
+					Status:         map[string]string{"hosting-chassis": "network-2"},
+				},
+				&nbdb.LogicalRouterPort{
+					UUID: "lrp-internal",
+					Name: "lrp-" + testPortUUID2,
+				},
+				&nbdb.NAT{UUID: "nat-1", Type: nbdb.NATTypeSNAT, ExternalIP: "203.0.113.5", LogicalIP: "10.0.0.0/24"},
+				&nbdb.LogicalRouterStaticRoute{UUID: "route-1", IPPrefix: "0.0.0.0/0", Nexthop: "203.0.113.1"},
+				&nbdb.LogicalRouterStaticRoute{UUID: "route-2", IPPrefix: "::/0", Nexthop: "2001:db8::1"},
+				&nbdb.LogicalRouter{
+					Name:         "neutron-" + testRouterUUID,
+					Enabled:      ptr.To(false),
+					Ports:        []string{"lrp-gw", "lrp-internal"},
+					Nat:          []string{"nat-1"},
+					StaticRoutes: []string{"route-1", "route-2"},
+				},
+			},
+			expected: apiv1alpha1.RouterStatus{
+				Agent:       "network-2",
+				ExternalIPs: []string{"203.0.113.5/24"},
+				Enabled:     false,
+				GatewayChassis: []apiv1alpha1.RouterGatewayChassis{
+					{Name: "network-2", Priority: 2},
+					{Name: "network-1", Priority: 1},
+				},
+				NATs:         1,
+				StaticRoutes: 2,
+			},
+		},
+		{
+			name: "router with HA chassis group",
+			nbData: []libovsdb.TestData{
+				&nbdb.HAChassis{UUID: "hc-1", ChassisName: "network-1", Priority: 3},
+				&nbdb.HAChassis{UUID: "hc-2", ChassisName: "network-2", Priority: 1},
+				&nbdb.HAChassis{UUID: "hc-3", ChassisName: "network-3", Priority: 2},
+				&nbdb.HAChassisGroup{UUID: "hcg", Name: "hcg", HaChassis: []string{"hc-1", "hc-2", "hc-3"}},
+				&nbdb.LogicalRouterPort{
+					UUID:           "lrp-gw",
+					Name:           "lrp-" + testPortUUID1,
+					Networks:       []string{"203.0.113.5/24"},
+					ExternalIDs:    map[string]string{"neutron:is_ext_gw": "True"},
+					HaChassisGroup: ptr.To("hcg"),
+					Status:         map[string]string{"hosting-chassis": "network-1"},
+				},
+				&nbdb.LogicalRouter{
+					Name:  "neutron-" + testRouterUUID,
+					Ports: []string{"lrp-gw"},
+				},
+			},
+			expected: apiv1alpha1.RouterStatus{
+				Agent:       "network-1",
+				ExternalIPs: []string{"203.0.113.5/24"},
+				Enabled:     true,
+				GatewayChassis: []apiv1alpha1.RouterGatewayChassis{
+					{Name: "network-1", Priority: 3},
+					{Name: "network-3", Priority: 2},
+					{Name: "network-2", Priority: 1},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			nbClient, cleanup, err := libovsdb.NewNBTestHarness(libovsdb.TestSetup{
+				NBData: tt.nbData,
+			}, nil)
+			require.NoError(t, err)
+			t.Cleanup(cleanup.Cleanup)
+
+			manager := NewManager(nbClient)
+			router, err := manager.GetByUUID(ctx, testRouterUUID)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected.Agent, router.Status.Agent)
+			assert.Equal(t, tt.expected.ExternalIPs, router.Status.ExternalIPs)
+			assert.Equal(t, tt.expected.Enabled, router.Status.Enabled)
+			assert.Equal(t, tt.expected.GatewayChassis, router.Status.GatewayChassis)
+			assert.Equal(t, tt.expected.NATs, router.Status.NATs)
+			assert.Equal(t, tt.expected.StaticRoutes, router.Status.StaticRoutes)
+		})
+	}
+}
+
+func TestRouter_Labels(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	externalIDs := map[string]string{
+		"neutron:router_name":             "router-1",
+		"neutron:availability_zone_hints": "az1",
+	}
+
+	nbClient, cleanup, err := libovsdb.NewNBTestHarness(libovsdb.TestSetup{
+		NBData: []libovsdb.TestData{
+			&nbdb.LogicalRouter{
+				Name:        "neutron-" + testRouterUUID,
+				ExternalIDs: externalIDs,
+			},
+		},
+	}, nil)
+	require.NoError(t, err)
+	t.Cleanup(cleanup.Cleanup)
+
+	router, err := NewManager(nbClient).GetByUUID(ctx, testRouterUUID)
+	require.NoError(t, err)
+
+	assert.Equal(t, externalIDs, router.Labels)
+}
+
+func TestListByHostingChassis(t *testing.T) {
+	nbData := []libovsdb.TestData{
+		&nbdb.LogicalRouter{
+			Name:  "neutron-" + testRouterUUID,
+			Ports: []string{testPortUUID1},
+		},
+		&nbdb.LogicalRouterPort{
+			UUID:           testPortUUID1,
+			Name:           "lrp-1",
+			ExternalIDs:    map[string]string{"neutron:is_ext_gw": "True"},
+			GatewayChassis: []string{testChassisUUID},
+		},
+		&nbdb.GatewayChassis{
+			UUID:        testChassisUUID,
+			Name:        "lrp-1_gwc-1",
+			ChassisName: "gwc-1",
+			Priority:    1,
+		},
+		&nbdb.LogicalRouter{
+			Name:  "neutron-" + testRouterUUID2,
+			Ports: []string{testPortUUID2},
+		},
+		&nbdb.LogicalRouterPort{
+			UUID:           testPortUUID2,
+			Name:           "lrp-2",
+			ExternalIDs:    map[string]string{"neutron:is_ext_gw": "True"},
+			GatewayChassis: []string{testChassisUUID2},
+		},
+		&nbdb.GatewayChassis{
+			UUID:        testChassisUUID2,
+			Name:        "lrp-2_gwc-2",
+			ChassisName: "gwc-2",
+			Priority:    1,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		chassis  string
+		expected []types.UID
+	}{
+		{
+			name:     "chassis hosting a router",
+			chassis:  "gwc-1",
+			expected: []types.UID{testRouterUUID},
+		},
+		{
+			name:     "other chassis hosting a router",
+			chassis:  "gwc-2",
+			expected: []types.UID{testRouterUUID2},
+		},
+		{
+			name:     "chassis hosting no routers",
+			chassis:  "gwc-3",
+			expected: []types.UID{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			nbClient, cleanup := setupTestHarnessForTest(t, nbData)
+			t.Cleanup(cleanup.Cleanup)
+
+			// NOTE(mnaser): I hate this, but this gives a chance to the handlers to
+			//               reconcile and update the status field.
+			time.Sleep(10 * time.Millisecond)
+
+			manager := NewManager(nbClient)
+			list, err := manager.ListByHostingChassis(ctx, tt.chassis)
+			require.NoError(t, err)
+
+			require.Len(t, list.Items, len(tt.expected))
+			for _, router := range list.Items {
+				assert.Contains(t, tt.expected, router.UID)
+			}
+		})
+	}
+}
+
 func TestRouter_HostingAgent(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -290,11 +561,115 @@ func TestRouter_HostingAgent(t *testing.T) {
 	}
 }
 
+func TestRouter_HostingAgentSouthbound(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        map[string]string
+		chassis       *string
+		expectedAgent string
+		errorContains string
+	}{
+		{
+			name:          "status takes precedence",
+			status:        map[string]string{"hosting-chassis": "gwc-2"},
+			chassis:       ptr.To(testChassisUUID),
+			expectedAgent: "gwc-2",
+		},
+		{
+			name:          "chassisredirect port binding",
+			chassis:       ptr.To(testChassisUUID),
+			expectedAgent: "gwc-1",
+		},
+		{
+			name:          "unbound chassisredirect port",
+			errorContains: "no hosting-chassis found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			nbClient, sbClient, cleanup, err := libovsdb.NewNBSBTestHarness(libovsdb.TestSetup{
+				NBData: []libovsdb.TestData{
+					&nbdb.LogicalRouterPort{
+						UUID:        "lrp-gw",
+						Name:        "lrp-" + testPortUUID1,
+						ExternalIDs: map[string]string{"neutron:is_ext_gw": "True"},
+						Status:      tt.status,
+					},
+					&nbdb.LogicalRouter{
+						Name:  "neutron-" + testRouterUUID,
+						Ports: []string{"lrp-gw"},
+					},
+				},
+				SBData: []libovsdb.TestData{
+					&sbdb.Chassis{UUID: testChassisUUID, Name: "gwc-1"},
+					&sbdb.PortBinding{LogicalPort: "cr-lrp-" + testPortUUID1, Chassis: tt.chassis, TunnelKey: 1},
+				},
+			})
+			require.NoError(t, err)
+			t.Cleanup(cleanup.Cleanup)
+
+			manager := NewManager(nbClient, WithSouthbound(sbClient))
+
+			router, err := manager.GetByUUID(ctx, testRouterUUID)
+			require.NoError(t, err)
+
+			agent, err := manager.GetHostingAgent(ctx, router)
+			if tt.errorContains != "" {
+				assert.ErrorContains(t, err, tt.errorContains)
+				assert.Empty(t, router.Status.Agent)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedAgent, agent)
+			assert.Equal(t, tt.expectedAgent, router.Status.Agent)
+		})
+	}
+}
+
+func TestRouter_HostingAgentSouthboundFailure(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	nbClient, _ := setupTestHarnessForTest(t, []libovsdb.TestData{
+		&nbdb.LogicalRouterPort{
+			UUID:        "lrp-gw",
+			Name:        "lrp-" + testPortUUID1,
+			ExternalIDs: map[string]string{"neutron:is_ext_gw": "True"},
+		},
+		&nbdb.LogicalRouter{
+			Name:  "neutron-" + testRouterUUID,
+			Ports: []string{"lrp-gw"},
+		},
+	})
+
+	// The northbound client has no Port_Binding table, so the lookup of the
+	// chassisredirect port binding fails
+	manager := NewManager(nbClient, WithSouthbound(nbClient))
+
+	routers, err := manager.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, routers.Items, 1)
+	assert.Empty(t, routers.Items[0].Status.Agent)
+
+	router, err := manager.GetByUUID(ctx, testRouterUUID)
+	require.NoError(t, err)
+	assert.Empty(t, router.Status.Agent)
+
+	_, err = manager.GetHostingAgent(ctx, router)
+	assert.ErrorContains(t, err, "failed to get port binding")
+}
+
 func TestRouter_Failover(t *testing.T) {
 	tests := []struct {
 		name                  string
 		nbData                []libovsdb.TestData
 		expectedInitialAgent  string
+		targetChassis         string
 		expectedFailoverAgent string
 		expectError           bool
 		errorContains         string
@@ -423,6 +798,108 @@ func TestRouter_Failover(t *testing.T) {
 			expectedInitialAgent:  "gwc-3",
 			expectedFailoverAgent: "gwc-1",
 		},
+		{
+			name: "triple gateway chassis with target chassis",
+			nbData: []libovsdb.TestData{
+				&nbdb.LogicalRouter{
+					Name:  "neutron-" + testRouterUUID,
+					Ports: []string{testPortUUID1, testPortUUID2},
+				},
+				&nbdb.LogicalRouterPort{
+					UUID:           testPortUUID1,
+					Name:           "lrp-1",
+					ExternalIDs:    map[string]string{"neutron:is_ext_gw": "True"},
+					GatewayChassis: []string{testChassisUUID, testChassisUUID2, testChassisUUID3},
+				},
+				&nbdb.LogicalRouterPort{
+					UUID:           testPortUUID2,
+					Name:           "lrp-2",
+					GatewayChassis: []string{},
+				},
+				&nbdb.GatewayChassis{
+					UUID:        testChassisUUID,
+					Name:        "lrp-1_gwc-1",
+					ChassisName: "gwc-1",
+					Priority:    1,
+				},
+				&nbdb.GatewayChassis{
+					UUID:        testChassisUUID2,
+					Name:        "lrp-1_gwc-2",
+					ChassisName: "gwc-2",
+					Priority:    2,
+				},
+				&nbdb.GatewayChassis{
+					UUID:        testChassisUUID3,
+					Name:        "lrp-1_gwc-3",
+					ChassisName: "gwc-3",
+					Priority:    3,
+				},
+			},
+			expectedInitialAgent:  "gwc-3",
+			targetChassis:         "gwc-2",
+			expectedFailoverAgent: "gwc-2",
+		},
+		{
+			name: "target chassis already active",
+			nbData: []libovsdb.TestData{
+				&nbdb.LogicalRouter{
+					Name:  "neutron-" + testRouterUUID,
+					Ports: []string{testPortUUID1},
+				},
+				&nbdb.LogicalRouterPort{
+					UUID:           testPortUUID1,
+					Name:           "lrp-1",
+					ExternalIDs:    map[string]string{"neutron:is_ext_gw": "True"},
+					GatewayChassis: []string{testChassisUUID, testChassisUUID2},
+				},
+				&nbdb.GatewayChassis{
+					UUID:        testChassisUUID,
+					Name:        "lrp-1_gwc-1",
+					ChassisName: "gwc-1",
+					Priority:    1,
+				},
+				&nbdb.GatewayChassis{
+					UUID:        testChassisUUID2,
+					Name:        "lrp-1_gwc-2",
+					ChassisName: "gwc-2",
+					Priority:    2,
+				},
+			},
+			expectedInitialAgent:  "gwc-2",
+			targetChassis:         "gwc-2",
+			expectedFailoverAgent: "gwc-2",
+		},
+		{
+			name: "target chassis not a member",
+			nbData: []libovsdb.TestData{
+				&nbdb.LogicalRouter{
+					Name:  "neutron-" + testRouterUUID,
+					Ports: []string{testPortUUID1},
+				},
+				&nbdb.LogicalRouterPort{
+					UUID:           testPortUUID1,
+					Name:           "lrp-1",
+					ExternalIDs:    map[string]string{"neutron:is_ext_gw": "True"},
+					GatewayChassis: []string{testChassisUUID, testChassisUUID2},
+				},
+				&nbdb.GatewayChassis{
+					UUID:        testChassisUUID,
+					Name:        "lrp-1_gwc-1",
+					ChassisName: "gwc-1",
+					Priority:    1,
+				},
+				&nbdb.GatewayChassis{
+					UUID:        testChassisUUID2,
+					Name:        "lrp-1_gwc-2",
+					ChassisName: "gwc-2",
+					Priority:    2,
+				},
+			},
+			expectedInitialAgent: "gwc-2",
+			targetChassis:        "gwc-3",
+			expectError:          true,
+			errorContains:        "is not a member",
+		},
 	}
 
 	for _, tt := range tests {
@@ -447,7 +924,9 @@ func TestRouter_Failover(t *testing.T) {
 				assert.Equal(t, tt.expectedInitialAgent, agent)
 			}
 
-			err = manager.Failover(ctx, router)
+			err = manager.Failover(ctx, router, FailoverOptions{
+				TargetChassis: tt.targetChassis,
+			})
 
 			if tt.expectError {
 				require.Error(t, err)
@@ -466,3 +945,415 @@ func TestRouter_Failover(t *testing.T) {
 		})
 	}
 }
+
+// gatewayChassisTestData builds a router with a gateway port backed by either
+// Gateway_Chassis or HA_Chassis rows for the given chassis and priorities
+func gatewayChassisTestData(haChassisGroup bool, priorities map[string]int) []libovsdb.TestData {
+	chassisUUIDs := map[string]string{
+		"gwc-1": testChassisUUID,
+		"gwc-2": testChassisUUID2,
+		"gwc-3": testChassisUUID3,
+	}
+
+	lrp := &nbdb.LogicalRouterPort{
+		UUID:        testPortUUID1,
+		Name:        "lrp-1",
+		ExternalIDs: map[string]string{"neutron:is_ext_gw": "True"},
+	}
+
+	data := []libovsdb.TestData{
+		&nbdb.LogicalRouter{
+			Name:  "neutron-" + testRouterUUID,
+			Ports: []string{testPortUUID1},
+		},
+	}
+
+	var uuids []string
+	for _, name := range []string{"gwc-1", "gwc-2", "gwc-3"} {
+		priority, ok := priorities[name]
+		if !ok {
+			continue
+		}
+
+		uuids = append(uuids, chassisUUIDs[name])
+		if haChassisGroup {
+			data = append(data, &nbdb.HAChassis{
+				UUID:        chassisUUIDs[name],
+				ChassisName: name,
+				Priority:    priority,
+			})
+		} else {
+			data = append(data, &nbdb.GatewayChassis{
+				UUID:        chassisUUIDs[name],
+				Name:        "lrp-1_" + name,
+				ChassisName: name,
+				Priority:    priority,
+			})
+		}
+	}
+
+	if haChassisGroup {
+		lrp.HaChassisGroup = ptr.To(testGroupUUID)
+		data = append(data, &nbdb.HAChassisGroup{
+			UUID:      testGroupUUID,
+			Name:      "neutron-" + testRouterUUID,
+			HaChassis: uuids,
+		})
+	} else {
+		lrp.GatewayChassis = uuids
+	}
+
+	return append(data, lrp)
+}
+
+func TestRouter_FailoverStrategy(t *testing.T) {
+	tests := []struct {
+		name                  string
+		priorities            map[string]int
+		strategy              FailoverStrategy
+		targetChassis         string
+		expectedFailoverAgent string
+		expectedPriorities    map[string]int
+		expectError           bool
+		errorContains         string
+	}{
+		{
+			name:                  "swap",
+			priorities:            map[string]int{"gwc-1": 3, "gwc-2": 2, "gwc-3": 1},
+			strategy:              FailoverStrategySwap,
+			expectedFailoverAgent: "gwc-3",
+			expectedPriorities:    map[string]int{"gwc-1": 1, "gwc-2": 2, "gwc-3": 3},
+		},
+		{
+			name:                  "rotate",
+			priorities:            map[string]int{"gwc-1": 3, "gwc-2": 2, "gwc-3": 1},
+			strategy:              FailoverStrategyRotate,
+			expectedFailoverAgent: "gwc-2",
+			expectedPriorities:    map[string]int{"gwc-1": 1, "gwc-2": 3, "gwc-3": 2},
+		},
+		{
+			name:                  "rotate with target chassis",
+			priorities:            map[string]int{"gwc-1": 3, "gwc-2": 2, "gwc-3": 1},
+			strategy:              FailoverStrategyRotate,
+			targetChassis:         "gwc-3",
+			expectedFailoverAgent: "gwc-3",
+			expectedPriorities:    map[string]int{"gwc-1": 1, "gwc-2": 2, "gwc-3": 3},
+		},
+		{
+			name:                  "demote",
+			priorities:            map[string]int{"gwc-1": 30, "gwc-2": 20, "gwc-3": 10},
+			strategy:              FailoverStrategyDemote,
+			expectedFailoverAgent: "gwc-2",
+			expectedPriorities:    map[string]int{"gwc-1": 9, "gwc-2": 20, "gwc-3": 10},
+		},
+		{
+			name:                  "demote with target chassis",
+			priorities:            map[string]int{"gwc-1": 30, "gwc-2": 20, "gwc-3": 10},
+			strategy:              FailoverStrategyDemote,
+			targetChassis:         "gwc-3",
+			expectedFailoverAgent: "gwc-3",
+			expectedPriorities:    map[string]int{"gwc-1": 9, "gwc-2": 20, "gwc-3": 30},
+		},
+		{
+			name:                  "demote at minimum priority falls back to rotate",
+			priorities:            map[string]int{"gwc-1": 2, "gwc-2": 1, "gwc-3": 0},
+			strategy:              FailoverStrategyDemote,
+			expectedFailoverAgent: "gwc-2",
+			expectedPriorities:    map[string]int{"gwc-1": 0, "gwc-2": 2, "gwc-3": 1},
+		},
+		{
+			name:          "unknown strategy",
+			priorities:    map[string]int{"gwc-1": 2, "gwc-2": 1},
+			strategy:      "shuffle",
+			expectError:   true,
+			errorContains: "unknown failover strategy",
+		},
+	}
+
+	for _, haChassisGroup := range []bool{false, true} {
+		for _, tt := range tests {
+			name := tt.name
+			if haChassisGroup {
+				name += " (HA chassis group)"
+			}
+
+			t.Run(name, func(t *testing.T) {
+				ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+				defer cancel()
+
+				nbClient, cleanup := setupTestHarnessForTest(t, gatewayChassisTestData(haChassisGroup, tt.priorities))
+				t.Cleanup(cleanup.Cleanup)
+
+				manager := NewManager(nbClient)
+				router, err := manager.GetByUUID(ctx, testRouterUUID)
+				require.NoError(t, err)
+
+				// NOTE(mnaser): I hate this, but this gives a chance to the handlers to
+				//               reconcile and update the status field.
+				time.Sleep(10 * time.Millisecond)
+
+				err = manager.Failover(ctx, router, FailoverOptions{
+					Strategy:      tt.strategy,
+					TargetChassis: tt.targetChassis,
+				})
+
+				if tt.expectError {
+					require.Error(t, err)
+					assert.Contains(t, err.Error(), tt.errorContains)
+					return
+				}
+				require.NoError(t, err)
+
+				agent, err := manager.GetHostingAgent(ctx, router)
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedFailoverAgent, agent)
+
+				set, err := manager.getGatewayChassisSet(ctx, router)
+				require.NoError(t, err)
+				assert.Equal(t, haChassisGroup, set.HAChassisGroup)
+
+				priorities := map[string]int{}
+				for _, member := range set.Members {
+					priorities[member.ChassisName] = member.Priority
+				}
+				assert.Equal(t, tt.expectedPriorities, priorities)
+			})
+		}
+	}
+}
+
+func TestRouter_PlanFailover(t *testing.T) {
+	for _, haChassisGroup := range []bool{false, true} {
+		name := "gateway chassis"
+		table := nbdb.GatewayChassisTable
+		if haChassisGroup {
+			name = "HA chassis group"
+			table = nbdb.HAChassisTable
+		}
+
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			priorities := map[string]int{"gwc-1": 3, "gwc-2": 2, "gwc-3": 1}
+
+			nbClient, cleanup := setupTestHarnessForTest(t, gatewayChassisTestData(haChassisGroup, priorities))
+			t.Cleanup(cleanup.Cleanup)
+
+			manager := NewManager(nbClient)
+			router, err := manager.GetByUUID(ctx, testRouterUUID)
+			require.NoError(t, err)
+
+			plan, err := manager.PlanFailover(ctx, router, FailoverOptions{
+				Strategy: FailoverStrategyRotate,
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, router.UID, plan.UID)
+			assert.Equal(t, "rotate", plan.Spec.Strategy)
+			assert.Equal(t, "gwc-1", plan.Spec.CurrentChassis)
+			assert.Equal(t, "gwc-2", plan.Spec.TargetChassis)
+			assert.Equal(t, []apiv1alpha1.FailoverPriorityChange{
+				{Table: table, UUID: testChassisUUID, ChassisName: "gwc-1", OldPriority: 3, NewPriority: 1},
+				{Table: table, UUID: testChassisUUID2, ChassisName: "gwc-2", OldPriority: 2, NewPriority: 3},
+				{Table: table, UUID: testChassisUUID3, ChassisName: "gwc-3", OldPriority: 1, NewPriority: 2},
+			}, plan.Spec.Changes)
+
+			// Planning must not change any priorities
+			set, err := manager.getGatewayChassisSet(ctx, router)
+			require.NoError(t, err)
+			for _, member := range set.Members {
+				assert.Equal(t, priorities[member.ChassisName], member.Priority)
+			}
+		})
+	}
+}
+
+// fakeHealthChecker reports the chassis listed in unhealthy as not healthy
+type fakeHealthChecker struct {
+	unhealthy map[string]string
+}
+
+func (f *fakeHealthChecker) CheckGatewayChassis(_ context.Context, name string) error {
+	if reason, ok := f.unhealthy[name]; ok {
+		return errors.New(reason)
+	}
+
+	return nil
+}
+
+func TestRouter_PlanFailoverHealthCheck(t *testing.T) {
+	tests := []struct {
+		name           string
+		strategy       FailoverStrategy
+		targetChassis  string
+		unhealthy      map[string]string
+		expectedTarget string
+		expectedChange map[string]int
+		expectError    bool
+		errorContains  string
+	}{
+		{
+			name:           "swap skips unhealthy lowest priority chassis",
+			strategy:       FailoverStrategySwap,
+			unhealthy:      map[string]string{"gwc-3": "chassis \"gwc-3\" is not alive"},
+			expectedTarget: "gwc-2",
+			expectedChange: map[string]int{"gwc-1": 2, "gwc-2": 3},
+		},
+		{
+			name:           "rotate skips unhealthy next chassis",
+			strategy:       FailoverStrategyRotate,
+			unhealthy:      map[string]string{"gwc-2": "chassis \"gwc-2\" is not alive"},
+			expectedTarget: "gwc-3",
+			expectedChange: map[string]int{"gwc-1": 1, "gwc-3": 3},
+		},
+		{
+			name:           "demote skips unhealthy next chassis",
+			strategy:       FailoverStrategyDemote,
+			unhealthy:      map[string]string{"gwc-2": "chassis \"gwc-2\" is not alive"},
+			expectedTarget: "gwc-3",
+			expectedChange: map[string]int{"gwc-1": 0, "gwc-3": 3},
+		},
+		{
+			name:          "no healthy chassis",
+			strategy:      FailoverStrategySwap,
+			unhealthy:     map[string]string{"gwc-2": "chassis \"gwc-2\" is not alive", "gwc-3": "chassis \"gwc-3\" is not gateway capable"},
+			expectError:   true,
+			errorContains: "no healthy gateway chassis found",
+		},
+		{
+			name:          "unhealthy target chassis",
+			targetChassis: "gwc-3",
+			unhealthy:     map[string]string{"gwc-3": "chassis \"gwc-3\" is not alive"},
+			expectError:   true,
+			errorContains: "is not alive",
+		},
+		{
+			name:           "unhealthy active chassis is ignored",
+			strategy:       FailoverStrategySwap,
+			unhealthy:      map[string]string{"gwc-1": "chassis \"gwc-1\" is not alive"},
+			expectedTarget: "gwc-3",
+			expectedChange: map[string]int{"gwc-1": 1, "gwc-3": 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			priorities := map[string]int{"gwc-1": 3, "gwc-2": 2, "gwc-3": 1}
+
+			nbClient, cleanup := setupTestHarnessForTest(t, gatewayChassisTestData(false, priorities))
+			t.Cleanup(cleanup.Cleanup)
+
+			manager := NewManager(nbClient, WithHealthChecker(&fakeHealthChecker{unhealthy: tt.unhealthy}))
+			router, err := manager.GetByUUID(ctx, testRouterUUID)
+			require.NoError(t, err)
+
+			plan, err := manager.PlanFailover(ctx, router, FailoverOptions{
+				TargetChassis: tt.targetChassis,
+				Strategy:      tt.strategy,
+			})
+			if tt.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedTarget, plan.Spec.TargetChassis)
+
+			changes := map[string]int{}
+			for _, change := range plan.Spec.Changes {
+				changes[change.ChassisName] = change.NewPriority
+			}
+			assert.Equal(t, tt.expectedChange, changes)
+		})
+	}
+}
+
+func TestRouter_FailoverConflict(t *testing.T) {
+	tests := []struct {
+		name                  string
+		retries               int
+		expectedFailoverAgent string
+		expectedPriorities    map[string]int
+		expectError           bool
+	}{
+		{
+			name:               "no retries",
+			retries:            0,
+			expectedPriorities: map[string]int{"gwc-1": 3, "gwc-2": 1, "gwc-3": 4},
+			expectError:        true,
+		},
+		{
+			name:                  "retry with a new plan",
+			retries:               1,
+			expectedFailoverAgent: "gwc-1",
+			expectedPriorities:    map[string]int{"gwc-1": 4, "gwc-2": 3, "gwc-3": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			priorities := map[string]int{"gwc-1": 3, "gwc-2": 2, "gwc-3": 1}
+
+			nbClient, cleanup := setupTestHarnessForTest(t, gatewayChassisTestData(false, priorities))
+			t.Cleanup(cleanup.Cleanup)
+
+			manager := NewManager(nbClient)
+			router, err := manager.GetByUUID(ctx, testRouterUUID)
+			require.NoError(t, err)
+
+			opts := FailoverOptions{
+				Strategy: FailoverStrategyRotate,
+				Retries:  tt.retries,
+			}
+
+			plan, err := manager.PlanFailover(ctx, router, opts)
+			require.NoError(t, err)
+
+			// Simulate a concurrent change of the priorities after planning
+			err = manager.updatePriorities(ctx, []apiv1alpha1.FailoverPriorityChange{
+				{Table: nbdb.GatewayChassisTable, UUID: testChassisUUID2, OldPriority: 2, NewPriority: 1},
+				{Table: nbdb.GatewayChassisTable, UUID: testChassisUUID3, OldPriority: 1, NewPriority: 4},
+			})
+			require.NoError(t, err)
+
+			applied, err := manager.ApplyFailoverPlan(ctx, router, plan, opts)
+			if tt.expectError {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, ErrFailoverConflict)
+				assert.Nil(t, applied)
+			} else {
+				require.NoError(t, err)
+
+				// The recomputed plan must be returned so that it is the one
+				// recorded in the journal
+				require.NotNil(t, applied)
+				changes := map[string]int{}
+				for _, change := range applied.Spec.Changes {
+					changes[change.ChassisName] = change.NewPriority
+				}
+				assert.Equal(t, tt.expectedPriorities, changes)
+
+				agent, err := manager.GetHostingAgent(ctx, router)
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedFailoverAgent, agent)
+			}
+
+			set, err := manager.getGatewayChassisSet(ctx, router)
+			require.NoError(t, err)
+
+			actual := map[string]int{}
+			for _, member := range set.Members {
+				actual[member.ChassisName] = member.Priority
+			}
+			assert.Equal(t, tt.expectedPriorities, actual)
+		})
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovnrouter
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+)
+
+// RebalanceOptions controls how routers are rebalanced across gateway chassis
+type RebalanceOptions struct {
+	// Weights is the relative weight of each chassis, chassis which are not
+	// listed have a weight of 1. A chassis with a weight of 0 will not receive
+	// any routers and all routers hosted on it will be moved away.
+	Weights map[string]int
+
+	// MaxMoves is the maximum number of routers to move, 0 means no limit
+	MaxMoves int
+}
+
+// RebalanceMove describes a router which should be moved to another chassis
+type RebalanceMove struct {
+	Router apiv1alpha1.Router
+	From   string
+	To     string
+}
+
+// rebalanceCandidate is a router which can be moved along with the chassis
+// which are members of its gateway set
+type rebalanceCandidate struct {
+	router  apiv1alpha1.Router
+	chassis []string
+}
+
+// PlanRebalance computes the moves required to even out the number of active
+// routers per gateway chassis, taking into account the weight of each chassis.
+//
+// Only the healthy chassis which are members of the gateway set of a router are
+// considered as destinations for it, and each router is moved at most once.
+// Routers without a hosting chassis or with a single gateway chassis are left
+// untouched.
+func (m *Manager) PlanRebalance(ctx context.Context, opts RebalanceOptions) ([]RebalanceMove, error) {
+	for chassis, weight := range opts.Weights {
+		if weight < 0 {
+			return nil, fmt.Errorf("weight for chassis %q must not be negative", chassis)
+		}
+	}
+
+	routers, err := m.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list routers: %w", err)
+	}
+
+	// Cache the health of every chassis since they are shared by many routers
+	healthy := map[string]bool{}
+
+	var candidates []rebalanceCandidate
+	for _, router := range routers.Items {
+		if router.Status.Agent == "" {
+			continue
+		}
+
+		set, err := m.getGatewayChassisSet(ctx, &router)
+		if err != nil {
+			continue
+		}
+
+		// Only keep the hosting chassis and the healthy destinations
+		candidate := rebalanceCandidate{router: router}
+		for _, member := range set.Members {
+			if member.ChassisName != router.Status.Agent {
+				ok, checked := healthy[member.ChassisName]
+				if !checked {
+					ok = m.checkGatewayChassis(ctx, member.ChassisName) == nil
+					healthy[member.ChassisName] = ok
+				}
+
+				if !ok {
+					continue
+				}
+			}
+
+			candidate.chassis = append(candidate.chassis, member.ChassisName)
+		}
+
+		candidates = append(candidates, candidate)
+	}
+
+	return planRebalance(candidates, opts), nil
+}
+
+// planRebalance greedily moves routers from the most loaded chassis to the
+// least loaded one among the members of their gateway set, as long as the move
+// strictly improves the balance between the two chassis.
+func planRebalance(candidates []rebalanceCandidate, opts RebalanceOptions) []RebalanceMove {
+	weight := func(chassis string) int {
+		if w, ok := opts.Weights[chassis]; ok {
+			return w
+		}
+
+		return 1
+	}
+
+	// load returns the number of routers per unit of weight of a chassis
+	load := func(routers int, chassis string) float64 {
+		w := weight(chassis)
+		if w == 0 {
+			if routers == 0 {
+				return 0
+			}
+
+			return math.Inf(1)
+		}
+
+		return float64(routers) / float64(w)
+	}
+
+	// Count the active routers for every chassis which is a gateway member of
+	// at least one router, so that idle chassis are taken into account
+	counts := map[string]int{}
+	hosted := map[string][]*rebalanceCandidate{}
+	for i := range candidates {
+		candidate := &candidates[i]
+		for _, chassis := range candidate.chassis {
+			if _, ok := counts[chassis]; !ok {
+				counts[chassis] = 0
+			}
+		}
+
+		counts[candidate.router.Status.Agent]++
+		hosted[candidate.router.Status.Agent] = append(hosted[candidate.router.Status.Agent], candidate)
+	}
+
+	for _, routers := range hosted {
+		sort.Slice(routers, func(i, j int) bool {
+			return routers[i].router.UID < routers[j].router.UID
+		})
+	}
+
+	chassisNames := make([]string, 0, len(counts))
+	for chassis := range counts {
+		chassisNames = append(chassisNames, chassis)
+	}
+
+	var moves []RebalanceMove
+	for opts.MaxMoves == 0 || len(moves) < opts.MaxMoves {
+		// Look at the most loaded chassis first
+		sort.SliceStable(chassisNames, func(i, j int) bool {
+			li, lj := load(counts[chassisNames[i]], chassisNames[i]), load(counts[chassisNames[j]], chassisNames[j])
+			if li != lj {
+				return li > lj
+			}
+
+			return chassisNames[i] < chassisNames[j]
+		})
+
+		move, ok := nextRebalanceMove(chassisNames, counts, hosted, load)
+		if !ok {
+			break
+		}
+
+		moves = append(moves, move)
+	}
+
+	return moves
+}
+
+// nextRebalanceMove finds the best move from the most loaded chassis which has
+// a router that can be moved to a less loaded chassis, and applies it to the
+// counts and hosted routers
+func nextRebalanceMove(
+	chassisNames []string,
+	counts map[string]int,
+	hosted map[string][]*rebalanceCandidate,
+	load func(int, string) float64,
+) (RebalanceMove, bool) {
+	for _, from := range chassisNames {
+		fromLoad := load(counts[from], from)
+
+		for i, candidate := range hosted[from] {
+			var to string
+			toLoad := math.Inf(1)
+
+			for _, chassis := range candidate.chassis {
+				if chassis == from {
+					continue
+				}
+
+				l := load(counts[chassis]+1, chassis)
+				if l < toLoad || (l == toLoad && chassis < to) {
+					to, toLoad = chassis, l
+				}
+			}
+
+			// Only move if the destination ends up less loaded than the source is today
+			if to == "" || toLoad >= fromLoad {
+				continue
+			}
+
+			counts[from]--
+			counts[to]++
+			hosted[from] = append(hosted[from][:i:i], hosted[from][i+1:]...)
+
+			return RebalanceMove{
+				Router: candidate.router,
+				From:   from,
+				To:     to,
+			}, true
+		}
+	}
+
+	return RebalanceMove{}, false
+}
@@ -0,0 +1,127 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovnrouter
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+)
+
+// rebalanceCandidates builds candidates hosted on the given chassis, all of
+// them having the same gateway chassis members
+func rebalanceCandidates(members []string, hosted map[string]int) []rebalanceCandidate {
+	var candidates []rebalanceCandidate
+
+	for _, chassis := range members {
+		for i := 0; i < hosted[chassis]; i++ {
+			candidates = append(candidates, rebalanceCandidate{
+				router: apiv1alpha1.Router{
+					ObjectMeta: metav1.ObjectMeta{
+						UID: types.UID(fmt.Sprintf("%s-router-%d", chassis, i)),
+					},
+					Status: apiv1alpha1.RouterStatus{
+						Agent: chassis,
+					},
+				},
+				chassis: members,
+			})
+		}
+	}
+
+	return candidates
+}
+
+func TestPlanRebalance(t *testing.T) {
+	members := []string{"gwc-1", "gwc-2", "gwc-3"}
+
+	tests := []struct {
+		name       string
+		candidates []rebalanceCandidate
+		opts       RebalanceOptions
+		expected   map[string]int
+		moves      int
+	}{
+		{
+			name:       "already balanced",
+			candidates: rebalanceCandidates(members, map[string]int{"gwc-1": 2, "gwc-2": 2, "gwc-3": 2}),
+			expected:   map[string]int{"gwc-1": 2, "gwc-2": 2, "gwc-3": 2},
+			moves:      0,
+		},
+		{
+			name:       "returning chassis with no routers",
+			candidates: rebalanceCandidates(members, map[string]int{"gwc-1": 6, "gwc-2": 6}),
+			expected:   map[string]int{"gwc-1": 4, "gwc-2": 4, "gwc-3": 4},
+			moves:      4,
+		},
+		{
+			name:       "uneven number of routers",
+			candidates: rebalanceCandidates(members, map[string]int{"gwc-1": 7}),
+			expected:   map[string]int{"gwc-1": 3, "gwc-2": 2, "gwc-3": 2},
+			moves:      4,
+		},
+		{
+			name:       "maximum number of moves",
+			candidates: rebalanceCandidates(members, map[string]int{"gwc-1": 6, "gwc-2": 6}),
+			opts:       RebalanceOptions{MaxMoves: 1},
+			expected:   map[string]int{"gwc-1": 5, "gwc-2": 6, "gwc-3": 1},
+			moves:      1,
+		},
+		{
+			name:       "weighted chassis",
+			candidates: rebalanceCandidates(members, map[string]int{"gwc-1": 8}),
+			opts:       RebalanceOptions{Weights: map[string]int{"gwc-3": 2}},
+			expected:   map[string]int{"gwc-1": 2, "gwc-2": 2, "gwc-3": 4},
+			moves:      6,
+		},
+		{
+			name:       "zero weight chassis is drained",
+			candidates: rebalanceCandidates(members, map[string]int{"gwc-1": 2, "gwc-2": 2, "gwc-3": 2}),
+			opts:       RebalanceOptions{Weights: map[string]int{"gwc-1": 0}},
+			expected:   map[string]int{"gwc-1": 0, "gwc-2": 3, "gwc-3": 3},
+			moves:      2,
+		},
+		{
+			name: "routers are only moved within their gateway set",
+			candidates: append(
+				rebalanceCandidates([]string{"gwc-1", "gwc-2"}, map[string]int{"gwc-1": 4}),
+				rebalanceCandidates([]string{"gwc-3"}, map[string]int{"gwc-3": 1})...,
+			),
+			expected: map[string]int{"gwc-1": 2, "gwc-2": 2, "gwc-3": 1},
+			moves:    2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			moves := planRebalance(tt.candidates, tt.opts)
+			assert.Len(t, moves, tt.moves)
+
+			counts := map[string]int{}
+			for _, candidate := range tt.candidates {
+				for _, chassis := range candidate.chassis {
+					counts[chassis] += 0
+				}
+				counts[candidate.router.Status.Agent]++
+			}
+
+			moved := map[types.UID]bool{}
+			for _, move := range moves {
+				assert.False(t, moved[move.Router.UID], "router %q moved more than once", move.Router.UID)
+				moved[move.Router.UID] = true
+
+				assert.Equal(t, move.Router.Status.Agent, move.From)
+				counts[move.From]--
+				counts[move.To]++
+			}
+
+			assert.Equal(t, tt.expected, counts)
+		})
+	}
+}
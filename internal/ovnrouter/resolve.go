@@ -0,0 +1,119 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovnrouter
+
+import (
+	"fmt"
+	"strings"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+)
+
+// projectIDKeys are the external IDs holding the ID of the Neutron project
+// owning a router, older releases of Neutron used the tenant ID
+var projectIDKeys = []string{"neutron:project_id", "neutron:tenant_id"}
+
+// AmbiguousReferenceError is returned when a reference to a router matches
+// more than one router
+type AmbiguousReferenceError struct {
+	// Reference is the name or UUID prefix given to select the router
+	Reference string
+
+	// Candidates are the routers matching the reference
+	Candidates []apiv1alpha1.Router
+}
+
+// Error returns the error message listing the candidates
+func (e *AmbiguousReferenceError) Error() string {
+	candidates := make([]string, 0, len(e.Candidates))
+	for _, router := range e.Candidates {
+		candidates = append(candidates, fmt.Sprintf("%s (%s)", router.Name, router.UID))
+	}
+
+	return fmt.Sprintf("router %q is ambiguous, it matches %d routers: %s", e.Reference, len(e.Candidates), strings.Join(candidates, ", "))
+}
+
+// ProjectID returns the ID of the Neutron project owning the router, or an
+// empty string if it is unknown
+func ProjectID(router *apiv1alpha1.Router) string {
+	for _, key := range projectIDKeys {
+		if id := router.Labels[key]; id != "" {
+			return id
+		}
+	}
+
+	return ""
+}
+
+// FilterByProject returns the routers owned by the given Neutron project
+func FilterByProject(routers []apiv1alpha1.Router, projectID string) []apiv1alpha1.Router {
+	filtered := make([]apiv1alpha1.Router, 0, len(routers))
+	for _, router := range routers {
+		if ProjectID(&router) == projectID {
+			filtered = append(filtered, router)
+		}
+	}
+
+	return filtered
+}
+
+// Resolve returns the routers referenced by the given UUIDs, Neutron names or
+// UUID prefixes, in the order of the references. A full UUID takes precedence
+// over a name, which takes precedence over a UUID prefix, and a reference
+// matching more than one router returns an AmbiguousReferenceError.
+func Resolve(routers []apiv1alpha1.Router, references []string) ([]apiv1alpha1.Router, error) {
+	resolved := make([]apiv1alpha1.Router, 0, len(references))
+	for _, reference := range references {
+		router, err := resolve(routers, reference)
+		if err != nil {
+			return nil, err
+		}
+
+		resolved = append(resolved, *router)
+	}
+
+	return resolved, nil
+}
+
+// resolve returns the router referenced by a UUID, name or UUID prefix
+func resolve(routers []apiv1alpha1.Router, reference string) (*apiv1alpha1.Router, error) {
+	if reference == "" {
+		return nil, fmt.Errorf("router reference must not be empty")
+	}
+
+	for i := range routers {
+		if string(routers[i].UID) == reference {
+			return &routers[i], nil
+		}
+	}
+
+	matchers := []func(router *apiv1alpha1.Router) bool{
+		func(router *apiv1alpha1.Router) bool {
+			return router.Name == reference
+		},
+		func(router *apiv1alpha1.Router) bool {
+			return strings.HasPrefix(string(router.UID), reference)
+		},
+	}
+
+	for _, matches := range matchers {
+		var candidates []apiv1alpha1.Router
+		for _, router := range routers {
+			if matches(&router) {
+				candidates = append(candidates, router)
+			}
+		}
+
+		switch len(candidates) {
+		case 0:
+			continue
+		case 1:
+			return &candidates[0], nil
+		default:
+			return nil, &AmbiguousReferenceError{Reference: reference, Candidates: candidates}
+		}
+	}
+
+	return nil, fmt.Errorf("router %q not found", reference)
+}
@@ -0,0 +1,150 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovnrouter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+)
+
+const testRouterUUID3 = "3a6b4831-c71b-46f0-bfdc-a0bd189db632"
+
+func testResolveRouters() []apiv1alpha1.Router {
+	return []apiv1alpha1.Router{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "router-1",
+				UID:    testRouterUUID,
+				Labels: map[string]string{"neutron:project_id": "project-1"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "router-2",
+				UID:    testRouterUUID2,
+				Labels: map[string]string{"neutron:tenant_id": "project-2"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "router-2",
+				UID:  testRouterUUID3,
+			},
+		},
+	}
+}
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name       string
+		references []string
+		expected   []types.UID
+		ambiguous  []types.UID
+		err        string
+	}{
+		{
+			name:       "uuid",
+			references: []string{testRouterUUID2},
+			expected:   []types.UID{testRouterUUID2},
+		},
+		{
+			name:       "name",
+			references: []string{"router-1"},
+			expected:   []types.UID{testRouterUUID},
+		},
+		{
+			name:       "uuid prefix",
+			references: []string{"366b"},
+			expected:   []types.UID{testRouterUUID2},
+		},
+		{
+			name:       "multiple references",
+			references: []string{"366b", "router-1"},
+			expected:   []types.UID{testRouterUUID2, testRouterUUID},
+		},
+		{
+			name:       "ambiguous name",
+			references: []string{"router-2"},
+			ambiguous:  []types.UID{testRouterUUID2, testRouterUUID3},
+		},
+		{
+			name:       "ambiguous uuid prefix",
+			references: []string{"3"},
+			ambiguous:  []types.UID{testRouterUUID2, testRouterUUID3},
+		},
+		{
+			name:       "not found",
+			references: []string{"router-3"},
+			err:        `router "router-3" not found`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			routers, err := Resolve(testResolveRouters(), tt.references)
+
+			switch {
+			case tt.err != "":
+				assert.EqualError(t, err, tt.err)
+			case tt.ambiguous != nil:
+				var ambiguousErr *AmbiguousReferenceError
+				require.ErrorAs(t, err, &ambiguousErr)
+
+				uids := []types.UID{}
+				for _, router := range ambiguousErr.Candidates {
+					uids = append(uids, router.UID)
+				}
+				assert.Equal(t, tt.ambiguous, uids)
+			default:
+				require.NoError(t, err)
+
+				uids := []types.UID{}
+				for _, router := range routers {
+					uids = append(uids, router.UID)
+				}
+				assert.Equal(t, tt.expected, uids)
+			}
+		})
+	}
+}
+
+func TestFilterByProject(t *testing.T) {
+	tests := []struct {
+		name      string
+		projectID string
+		expected  []types.UID
+	}{
+		{
+			name:      "project id",
+			projectID: "project-1",
+			expected:  []types.UID{testRouterUUID},
+		},
+		{
+			name:      "tenant id",
+			projectID: "project-2",
+			expected:  []types.UID{testRouterUUID2},
+		},
+		{
+			name:      "unknown project",
+			projectID: "project-3",
+			expected:  []types.UID{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uids := []types.UID{}
+			for _, router := range FilterByProject(testResolveRouters(), tt.projectID) {
+				uids = append(uids, router.UID)
+			}
+			assert.Equal(t, tt.expected, uids)
+		})
+	}
+}
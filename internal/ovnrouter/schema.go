@@ -0,0 +1,90 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovnrouter
+
+import (
+	"context"
+	"maps"
+
+	"github.com/ovn-org/libovsdb/model"
+	"github.com/ovn-org/libovsdb/ovsdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+)
+
+// legacyLogicalRouterPort is the model of the Logical_Router_Port table of
+// northbound schemas older than OVN 23.09, which have no status column
+type legacyLogicalRouterPort struct {
+	UUID           string            `ovsdb:"_uuid"`
+	Enabled        *bool             `ovsdb:"enabled"`
+	ExternalIDs    map[string]string `ovsdb:"external_ids"`
+	GatewayChassis []string          `ovsdb:"gateway_chassis"`
+	HaChassisGroup *string           `ovsdb:"ha_chassis_group"`
+	Ipv6Prefix     []string          `ovsdb:"ipv6_prefix"`
+	Ipv6RaConfigs  map[string]string `ovsdb:"ipv6_ra_configs"`
+	MAC            string            `ovsdb:"mac"`
+	Name           string            `ovsdb:"name"`
+	Networks       []string          `ovsdb:"networks"`
+	Options        map[string]string `ovsdb:"options"`
+	Peer           *string           `ovsdb:"peer"`
+}
+
+// logicalRouterPort converts the row to the model of the current schema
+func (a *legacyLogicalRouterPort) logicalRouterPort() *nbdb.LogicalRouterPort {
+	return &nbdb.LogicalRouterPort{
+		UUID:           a.UUID,
+		Enabled:        a.Enabled,
+		ExternalIDs:    a.ExternalIDs,
+		GatewayChassis: a.GatewayChassis,
+		HaChassisGroup: a.HaChassisGroup,
+		Ipv6Prefix:     a.Ipv6Prefix,
+		Ipv6RaConfigs:  a.Ipv6RaConfigs,
+		MAC:            a.MAC,
+		Name:           a.Name,
+		Networks:       a.Networks,
+		Options:        a.Options,
+		Peer:           a.Peer,
+	}
+}
+
+// schemaHasPortStatus returns true if the northbound schema has the status
+// column of logical router ports
+func schemaHasPortStatus(schema ovsdb.DatabaseSchema) bool {
+	table := schema.Table(nbdb.LogicalRouterPortTable)
+	return table != nil && table.Column("status") != nil
+}
+
+// CompatibleTables returns the northbound tables with the model of the
+// Logical_Router_Port table replaced by one without the status column when the
+// given schema predates it, since libovsdb refuses to connect with a model
+// which has columns missing from the schema of the server
+func CompatibleTables(schema ovsdb.DatabaseSchema, tables map[string]model.Model) map[string]model.Model {
+	if _, ok := tables[nbdb.LogicalRouterPortTable]; !ok || schemaHasPortStatus(schema) {
+		return tables
+	}
+
+	tables = maps.Clone(tables)
+	tables[nbdb.LogicalRouterPortTable] = &legacyLogicalRouterPort{}
+
+	return tables
+}
+
+// getLogicalRouterPort retrieves a logical router port with the model matching
+// the schema of the northbound database, see CompatibleTables
+func (m *Manager) getLogicalRouterPort(ctx context.Context, uuid string) (*nbdb.LogicalRouterPort, error) {
+	if HasPortStatus(m.client) {
+		lrp := &nbdb.LogicalRouterPort{UUID: uuid}
+		if err := m.client.Get(ctx, lrp); err != nil {
+			return nil, err
+		}
+
+		return lrp, nil
+	}
+
+	lrp := &legacyLogicalRouterPort{UUID: uuid}
+	if err := m.client.Get(ctx, lrp); err != nil {
+		return nil, err
+	}
+
+	return lrp.logicalRouterPort(), nil
+}
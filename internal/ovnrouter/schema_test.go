@@ -0,0 +1,168 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovnrouter
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ovn-org/libovsdb/client"
+	"github.com/ovn-org/libovsdb/database/inmemory"
+	"github.com/ovn-org/libovsdb/model"
+	"github.com/ovn-org/libovsdb/ovsdb"
+	"github.com/ovn-org/libovsdb/server"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/sbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+// legacySchema returns the northbound schema without the status column of
+// logical router ports, like the schema of OVN releases before 23.09
+func legacySchema() ovsdb.DatabaseSchema {
+	schema := nbdb.Schema()
+	delete(schema.Tables[nbdb.LogicalRouterPortTable].Columns, "status")
+
+	return schema
+}
+
+// newLegacyServer starts a northbound server with the legacy schema and
+// returns its endpoint
+func newLegacyServer(t *testing.T) string {
+	schema := legacySchema()
+
+	clientModel, err := model.NewClientDBModel(schema.Name, CompatibleTables(schema, Tables()))
+	require.NoError(t, err)
+
+	dbModel, errs := model.NewDatabaseModel(schema, clientModel)
+	require.Empty(t, errs)
+
+	s, err := server.NewOvsdbServer(inmemory.NewDatabase(map[string]model.ClientDBModel{
+		schema.Name: clientModel,
+	}), dbModel)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "ovnnb_db.sock")
+	go func() {
+		_ = s.Serve("unix", path)
+	}()
+	t.Cleanup(s.Close)
+
+	require.Eventually(t, s.Ready, 5*time.Second, 10*time.Millisecond)
+
+	return "unix:" + path
+}
+
+// connectLegacy connects to the server with the given tables and monitors them
+func connectLegacy(ctx context.Context, endpoint string, tables map[string]model.Model) (client.Client, error) {
+	dbModel, err := model.NewClientDBModel(nbdb.Schema().Name, tables)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := client.NewOVSDBClient(dbModel, client.WithEndpoint(endpoint))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.Connect(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := c.MonitorAll(ctx); err != nil {
+		c.Close()
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func TestCompatibleTables(t *testing.T) {
+	tables := Tables()
+
+	assert.Equal(t, tables, CompatibleTables(nbdb.Schema(), tables))
+	assert.Equal(t, &legacyLogicalRouterPort{}, CompatibleTables(legacySchema(), tables)[nbdb.LogicalRouterPortTable])
+	assert.Equal(t, &nbdb.LogicalRouterPort{}, tables[nbdb.LogicalRouterPortTable])
+
+	// Tables without logical router ports are kept
+	tables = map[string]model.Model{nbdb.LogicalRouterTable: &nbdb.LogicalRouter{}}
+	assert.Equal(t, tables, CompatibleTables(legacySchema(), tables))
+}
+
+func TestRouter_HostingAgentLegacySchema(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	endpoint := newLegacyServer(t)
+
+	// The model of the current schema is refused by the server
+	_, err := connectLegacy(ctx, endpoint, Tables())
+	require.ErrorContains(t, err, "Column does not exist in schema")
+
+	// The schema is fetched by a client without any model first
+	probeModel, err := model.NewClientDBModel(nbdb.Schema().Name, map[string]model.Model{})
+	require.NoError(t, err)
+	probe, err := client.NewOVSDBClient(probeModel, client.WithEndpoint(endpoint))
+	require.NoError(t, err)
+	require.NoError(t, probe.Connect(ctx))
+	schema := probe.Schema()
+	probe.Close()
+
+	nbClient, err := connectLegacy(ctx, endpoint, CompatibleTables(schema, Tables()))
+	require.NoError(t, err)
+	t.Cleanup(nbClient.Close)
+
+	assert.False(t, HasPortStatus(nbClient))
+
+	ops, err := nbClient.Create(
+		&legacyLogicalRouterPort{
+			UUID:        "lrp",
+			Name:        "lrp-" + testPortUUID1,
+			ExternalIDs: map[string]string{"neutron:is_ext_gw": "True"},
+			Networks:    []string{"203.0.113.10/24"},
+		},
+		&nbdb.LogicalRouter{
+			Name:  "neutron-" + testRouterUUID,
+			Ports: []string{"lrp"},
+		},
+	)
+	require.NoError(t, err)
+	results, err := nbClient.Transact(ctx, ops...)
+	require.NoError(t, err)
+	_, err = ovsdb.CheckOperationResults(results, ops)
+	require.NoError(t, err)
+
+	sbClient, cleanup, err := libovsdb.NewSBTestHarness(libovsdb.TestSetup{
+		SBData: []libovsdb.TestData{
+			&sbdb.Chassis{UUID: testChassisUUID, Name: "gwc-1"},
+			&sbdb.PortBinding{LogicalPort: "cr-lrp-" + testPortUUID1, Chassis: ptr.To(testChassisUUID), TunnelKey: 1},
+		},
+	}, nil)
+	require.NoError(t, err)
+	t.Cleanup(cleanup.Cleanup)
+
+	require.Eventually(t, func() bool {
+		_, err := NewManager(nbClient).GetByUUID(ctx, testRouterUUID)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Without a southbound client the hosting chassis is unknown
+	router, err := NewManager(nbClient).GetByUUID(ctx, testRouterUUID)
+	require.NoError(t, err)
+	assert.Empty(t, router.Status.Agent)
+	assert.Equal(t, []string{"203.0.113.10/24"}, router.Status.ExternalIPs)
+
+	manager := NewManager(nbClient, WithSouthbound(sbClient))
+	router, err = manager.GetByUUID(ctx, testRouterUUID)
+	require.NoError(t, err)
+	assert.Equal(t, "gwc-1", router.Status.Agent)
+
+	agent, err := manager.GetHostingAgent(ctx, router)
+	require.NoError(t, err)
+	assert.Equal(t, "gwc-1", agent)
+}
@@ -0,0 +1,261 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovnrouter
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/ovn-org/libovsdb/model"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"k8s.io/utils/ptr"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+)
+
+// TopologyTables returns the northbound tables which must be monitored by the
+// client used by the Manager to retrieve the topology of routers
+func TopologyTables() map[string]model.Model {
+	tables := Tables()
+	tables[nbdb.LoadBalancerTable] = &nbdb.LoadBalancer{}
+	tables[nbdb.LogicalRouterStaticRouteTable] = &nbdb.LogicalRouterStaticRoute{}
+	tables[nbdb.LogicalSwitchPortTable] = &nbdb.LogicalSwitchPort{}
+	tables[nbdb.LogicalSwitchTable] = &nbdb.LogicalSwitch{}
+	tables[nbdb.NATTable] = &nbdb.NAT{}
+
+	return tables
+}
+
+// TopologyPort is a port of a router
+type TopologyPort struct {
+	Name      string
+	MAC       string
+	IsGateway bool
+
+	// Addresses are the IP addresses of the port with their prefix length
+	Addresses []string
+
+	// Network is the UUID of the network the port is attached to, if any
+	Network string
+}
+
+// TopologyGatewayChassis is a member of the gateway set of a router
+type TopologyGatewayChassis struct {
+	ChassisName string
+	Priority    int
+	Active      bool
+}
+
+// TopologyNAT is a NAT rule of a router
+type TopologyNAT struct {
+	Type        string
+	ExternalIP  string
+	LogicalIP   string
+	LogicalPort string
+}
+
+// TopologyStaticRoute is a static route of a router
+type TopologyStaticRoute struct {
+	IPPrefix   string
+	Nexthop    string
+	OutputPort string
+	Policy     string
+}
+
+// Topology holds everything connected to a router in the northbound database
+type Topology struct {
+	Enabled bool
+	Ports   []TopologyPort
+
+	// HAChassisGroup indicates if the gateway chassis are HA_Chassis rows,
+	// otherwise they are Gateway_Chassis rows
+	HAChassisGroup bool
+	GatewayChassis []TopologyGatewayChassis
+
+	NATs          []TopologyNAT
+	StaticRoutes  []TopologyStaticRoute
+	Networks      []string
+	LoadBalancers []string
+}
+
+// GetTopology retrieves the ports, gateway chassis, NAT rules, static routes,
+// attached networks and load balancers of the router
+func (m *Manager) GetTopology(ctx context.Context, router *apiv1alpha1.Router) (*Topology, error) {
+	lr := nbdb.LogicalRouter{UUID: string(*router.Status.InternalUUID)}
+	if err := m.client.Get(ctx, &lr); err != nil {
+		return nil, fmt.Errorf("failed to get logical router for router %q: %w", router.UID, err)
+	}
+
+	topology := &Topology{
+		Enabled: ptr.Deref(lr.Enabled, true),
+	}
+
+	if err := m.getTopologyPorts(ctx, &lr, topology); err != nil {
+		return nil, err
+	}
+
+	if err := m.getTopologyGatewayChassis(ctx, router, topology); err != nil {
+		return nil, err
+	}
+
+	for _, natUUID := range lr.Nat {
+		nat := nbdb.NAT{UUID: natUUID}
+		if err := m.client.Get(ctx, &nat); err != nil {
+			return nil, fmt.Errorf("failed to get NAT %q for router %q: %w", natUUID, router.UID, err)
+		}
+
+		topology.NATs = append(topology.NATs, TopologyNAT{
+			Type:        nat.Type,
+			ExternalIP:  nat.ExternalIP,
+			LogicalIP:   nat.LogicalIP,
+			LogicalPort: ptr.Deref(nat.LogicalPort, ""),
+		})
+	}
+
+	sort.Slice(topology.NATs, func(i, j int) bool {
+		if topology.NATs[i].Type != topology.NATs[j].Type {
+			return topology.NATs[i].Type < topology.NATs[j].Type
+		}
+
+		return topology.NATs[i].ExternalIP < topology.NATs[j].ExternalIP
+	})
+
+	for _, routeUUID := range lr.StaticRoutes {
+		route := nbdb.LogicalRouterStaticRoute{UUID: routeUUID}
+		if err := m.client.Get(ctx, &route); err != nil {
+			return nil, fmt.Errorf("failed to get static route %q for router %q: %w", routeUUID, router.UID, err)
+		}
+
+		topology.StaticRoutes = append(topology.StaticRoutes, TopologyStaticRoute{
+			IPPrefix:   route.IPPrefix,
+			Nexthop:    route.Nexthop,
+			OutputPort: ptr.Deref(route.OutputPort, ""),
+			Policy:     ptr.Deref(route.Policy, nbdb.LogicalRouterStaticRoutePolicyDstIP),
+		})
+	}
+
+	sort.Slice(topology.StaticRoutes, func(i, j int) bool {
+		return topology.StaticRoutes[i].IPPrefix < topology.StaticRoutes[j].IPPrefix
+	})
+
+	for _, lbUUID := range lr.LoadBalancer {
+		lb := nbdb.LoadBalancer{UUID: lbUUID}
+		if err := m.client.Get(ctx, &lb); err != nil {
+			return nil, fmt.Errorf("failed to get load balancer %q for router %q: %w", lbUUID, router.UID, err)
+		}
+
+		if !slices.Contains(topology.LoadBalancers, lb.Name) {
+			topology.LoadBalancers = append(topology.LoadBalancers, lb.Name)
+		}
+	}
+
+	sort.Strings(topology.LoadBalancers)
+
+	return topology, nil
+}
+
+// getTopologyPorts fills the ports of the router and the networks they are
+// attached to through logical switch ports of type `router`
+func (m *Manager) getTopologyPorts(ctx context.Context, lr *nbdb.LogicalRouter, topology *Topology) error {
+	switchPorts := []nbdb.LogicalSwitchPort{}
+	if err := m.client.WhereCache(func(lsp *nbdb.LogicalSwitchPort) bool {
+		return lsp.Type == "router"
+	}).List(ctx, &switchPorts); err != nil {
+		return fmt.Errorf("failed to list router logical switch ports: %w", err)
+	}
+
+	// Map the UUID of the logical switch ports to the name of the router port
+	// they are attached to
+	routerPorts := map[string]string{}
+	for _, lsp := range switchPorts {
+		if name, ok := lsp.Options["router-port"]; ok {
+			routerPorts[lsp.UUID] = name
+		}
+	}
+
+	switches := []nbdb.LogicalSwitch{}
+	if err := m.client.WhereCache(func(ls *nbdb.LogicalSwitch) bool {
+		for _, port := range ls.Ports {
+			if _, ok := routerPorts[port]; ok {
+				return true
+			}
+		}
+
+		return false
+	}).List(ctx, &switches); err != nil {
+		return fmt.Errorf("failed to list logical switches: %w", err)
+	}
+
+	portNetworks := map[string]string{}
+	for _, ls := range switches {
+		for _, port := range ls.Ports {
+			if name, ok := routerPorts[port]; ok {
+				portNetworks[name] = strings.TrimPrefix(ls.Name, "neutron-")
+			}
+		}
+	}
+
+	for _, portUUID := range lr.Ports {
+		lrp, err := m.getLogicalRouterPort(ctx, portUUID)
+		if err != nil {
+			return fmt.Errorf("failed to get logical router port %q for router %q: %w", portUUID, lr.Name, err)
+		}
+
+		port := TopologyPort{
+			Name:      lrp.Name,
+			MAC:       lrp.MAC,
+			IsGateway: lrp.ExternalIDs["neutron:is_ext_gw"] == "True",
+			Addresses: lrp.Networks,
+			Network:   portNetworks[lrp.Name],
+		}
+		topology.Ports = append(topology.Ports, port)
+
+		if port.Network != "" && !slices.Contains(topology.Networks, port.Network) {
+			topology.Networks = append(topology.Networks, port.Network)
+		}
+	}
+
+	sort.Slice(topology.Ports, func(i, j int) bool {
+		return topology.Ports[i].Name < topology.Ports[j].Name
+	})
+	sort.Strings(topology.Networks)
+
+	return nil
+}
+
+// getTopologyGatewayChassis fills the members of the gateway set of the router
+// from the highest to the lowest priority, routers without a gateway port or
+// without gateway chassis are left without members
+func (m *Manager) getTopologyGatewayChassis(ctx context.Context, router *apiv1alpha1.Router, topology *Topology) error {
+	if len(router.Status.GatewayChassis) == 0 {
+		return nil
+	}
+
+	lrp, err := m.getGatewayPort(ctx, router)
+	if err != nil {
+		return err
+	}
+
+	topology.HAChassisGroup = lrp.HaChassisGroup != nil
+
+	// NOTE: The active chassis is the one reported by OVN, the highest priority
+	//       member is only assumed to be active when OVN did not report one.
+	active := router.Status.Agent
+	if active == "" {
+		active = router.Status.GatewayChassis[0].Name
+	}
+
+	for _, member := range router.Status.GatewayChassis {
+		topology.GatewayChassis = append(topology.GatewayChassis, TopologyGatewayChassis{
+			ChassisName: member.Name,
+			Priority:    member.Priority,
+			Active:      member.Name == active,
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovnrouter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	testNetworkUUID = "5b3c1a2e-7f0d-4c1e-9b8a-2d6f4e3c1a0b"
+	testLBUUID      = "8e6f4a2c-1b3d-4e5f-9a7b-6c8d0e2f4a1b"
+)
+
+func TestRouter_GetTopology(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	nbClient, cleanup, err := libovsdb.NewNBTestHarness(libovsdb.TestSetup{
+		NBData: []libovsdb.TestData{
+			&nbdb.HAChassis{UUID: "hc-1", ChassisName: "network-1", Priority: 2},
+			&nbdb.HAChassis{UUID: "hc-2", ChassisName: "network-2", Priority: 1},
+			&nbdb.HAChassisGroup{UUID: "hcg", Name: "default_ha_chassis_group", HaChassis: []string{"hc-1", "hc-2"}},
+			&nbdb.LogicalRouterPort{
+				UUID:           "lrp-gw",
+				Name:           "lrp-" + testPortUUID1,
+				MAC:            "fa:16:3e:00:00:01",
+				Networks:       []string{"203.0.113.5/24"},
+				ExternalIDs:    map[string]string{"neutron:is_ext_gw": "True"},
+				HaChassisGroup: ptr.To("hcg"),
+				Status:         map[string]string{"hosting-chassis": "network-2"},
+			},
+			&nbdb.LogicalRouterPort{
+				UUID:     "lrp-internal",
+				Name:     "lrp-" + testPortUUID2,
+				MAC:      "fa:16:3e:00:00:02",
+				Networks: []string{"10.0.0.1/24"},
+			},
+			&nbdb.LogicalSwitchPort{
+				UUID:    "lsp-internal",
+				Name:    testPortUUID2,
+				Type:    "router",
+				Options: map[string]string{"router-port": "lrp-" + testPortUUID2},
+			},
+			&nbdb.LogicalSwitch{
+				Name:  "neutron-" + testNetworkUUID,
+				Ports: []string{"lsp-internal"},
+			},
+			&nbdb.NAT{
+				UUID:       "nat-snat",
+				Type:       nbdb.NATTypeSNAT,
+				ExternalIP: "203.0.113.5",
+				LogicalIP:  "10.0.0.0/24",
+			},
+			&nbdb.LogicalRouterStaticRoute{
+				UUID:     "route-default",
+				IPPrefix: "0.0.0.0/0",
+				Nexthop:  "203.0.113.1",
+			},
+			&nbdb.LoadBalancer{
+				UUID:     "lb-tcp",
+				Name:     testLBUUID,
+				Protocol: ptr.To(nbdb.LoadBalancerProtocolTCP),
+			},
+			&nbdb.LogicalRouter{
+				Name:         "neutron-" + testRouterUUID,
+				Enabled:      ptr.To(false),
+				Ports:        []string{"lrp-gw", "lrp-internal"},
+				Nat:          []string{"nat-snat"},
+				StaticRoutes: []string{"route-default"},
+				LoadBalancer: []string{"lb-tcp"},
+			},
+		},
+	}, nil)
+	require.NoError(t, err)
+	t.Cleanup(cleanup.Cleanup)
+
+	manager := NewManager(nbClient)
+	router, err := manager.GetByUUID(ctx, testRouterUUID)
+	require.NoError(t, err)
+
+	topology, err := manager.GetTopology(ctx, router)
+	require.NoError(t, err)
+
+	assert.False(t, topology.Enabled)
+
+	require.Len(t, topology.Ports, 2)
+	assert.Equal(t, "lrp-"+testPortUUID1, topology.Ports[0].Name)
+	assert.True(t, topology.Ports[0].IsGateway)
+	assert.Empty(t, topology.Ports[0].Network)
+	assert.Equal(t, "lrp-"+testPortUUID2, topology.Ports[1].Name)
+	assert.Equal(t, "fa:16:3e:00:00:02", topology.Ports[1].MAC)
+	assert.Equal(t, []string{"10.0.0.1/24"}, topology.Ports[1].Addresses)
+	assert.Equal(t, testNetworkUUID, topology.Ports[1].Network)
+	assert.Equal(t, []string{testNetworkUUID}, topology.Networks)
+
+	assert.True(t, topology.HAChassisGroup)
+	assert.Equal(t, []TopologyGatewayChassis{
+		{ChassisName: "network-1", Priority: 2, Active: false},
+		{ChassisName: "network-2", Priority: 1, Active: true},
+	}, topology.GatewayChassis)
+
+	assert.Equal(t, []TopologyNAT{
+		{Type: nbdb.NATTypeSNAT, ExternalIP: "203.0.113.5", LogicalIP: "10.0.0.0/24"},
+	}, topology.NATs)
+	assert.Equal(t, []TopologyStaticRoute{
+		{IPPrefix: "0.0.0.0/0", Nexthop: "203.0.113.1", Policy: nbdb.LogicalRouterStaticRoutePolicyDstIP},
+	}, topology.StaticRoutes)
+	assert.Equal(t, []string{testLBUUID}, topology.LoadBalancers)
+}
+
+func TestRouter_GetTopologyWithoutGateway(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	nbClient, cleanup, err := libovsdb.NewNBTestHarness(libovsdb.TestSetup{
+		NBData: []libovsdb.TestData{
+			&nbdb.LogicalRouter{
+				Name: "neutron-" + testRouterUUID,
+			},
+		},
+	}, nil)
+	require.NoError(t, err)
+	t.Cleanup(cleanup.Cleanup)
+
+	manager := NewManager(nbClient)
+	router, err := manager.GetByUUID(ctx, testRouterUUID)
+	require.NoError(t, err)
+
+	topology, err := manager.GetTopology(ctx, router)
+	require.NoError(t, err)
+
+	assert.True(t, topology.Enabled)
+	assert.Empty(t, topology.Ports)
+	assert.Empty(t, topology.GatewayChassis)
+}
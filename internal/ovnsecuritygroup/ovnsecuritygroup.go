@@ -0,0 +1,185 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovnsecuritygroup
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+	"strings"
+
+	"github.com/ovn-org/libovsdb/client"
+	"github.com/ovn-org/libovsdb/model"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+)
+
+const (
+	// securityGroupIDKey is the external ID holding the Neutron UUID of a
+	// security group
+	securityGroupIDKey = "neutron:security_group_id"
+
+	// securityGroupRuleIDKey is the external ID holding the Neutron UUID of a
+	// security group rule
+	securityGroupRuleIDKey = "neutron:security_group_rule_id"
+
+	// DirectionIngress is the direction of traffic going to a port
+	DirectionIngress = "ingress"
+
+	// DirectionEgress is the direction of traffic coming from a port
+	DirectionEgress = "egress"
+)
+
+// Tables returns the northbound tables which must be monitored by the client
+// used by the Manager
+func Tables() map[string]model.Model {
+	return map[string]model.Model{
+		nbdb.PortGroupTable: &nbdb.PortGroup{},
+		nbdb.ACLTable:       &nbdb.ACL{},
+	}
+}
+
+// Manager provides methods for managing OVN security groups
+type Manager struct {
+	client client.Client
+}
+
+// NewManager creates a new Manager instance with the given OVN client
+func NewManager(c client.Client) *Manager {
+	return &Manager{
+		client: c,
+	}
+}
+
+// isSecurityGroup returns true if the port group was created by Neutron for a
+// security group
+func isSecurityGroup(pg *nbdb.PortGroup) bool {
+	_, ok := pg.ExternalIDs[securityGroupIDKey]
+	return ok
+}
+
+// direction returns the direction of an ACL from the point of view of the
+// port, since `from-lport` ACLs match traffic sent by the port
+func direction(acl *nbdb.ACL) string {
+	if acl.Direction == nbdb.ACLDirectionFromLport {
+		return DirectionEgress
+	}
+
+	return DirectionIngress
+}
+
+func (m *Manager) convertToSecurityGroup(ctx context.Context, pg *nbdb.PortGroup) (*apiv1alpha1.SecurityGroup, error) {
+	uuid := types.UID(pg.ExternalIDs[securityGroupIDKey])
+
+	sg := &apiv1alpha1.SecurityGroup{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "SecurityGroup",
+			APIVersion: "atmosphere.vexxhost.com/v1alpha1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   string(uuid),
+			UID:    uuid,
+			Labels: maps.Clone(pg.ExternalIDs),
+		},
+		Status: apiv1alpha1.SecurityGroupStatus{
+			InternalUUID: ptr.To(types.UID(pg.UUID)),
+			PortGroup:    pg.Name,
+			Ports:        len(pg.Ports),
+		},
+	}
+
+	for _, aclUUID := range pg.ACLs {
+		acl := nbdb.ACL{UUID: aclUUID}
+		if err := m.client.Get(ctx, &acl); err != nil {
+			return nil, fmt.Errorf("failed to get ACL %q for security group %q: %w", aclUUID, uuid, err)
+		}
+
+		sg.Status.ACLs = append(sg.Status.ACLs, apiv1alpha1.SecurityGroupACL{
+			InternalUUID: types.UID(acl.UUID),
+			RuleID:       types.UID(acl.ExternalIDs[securityGroupRuleIDKey]),
+			Direction:    direction(&acl),
+			Priority:     acl.Priority,
+			Action:       acl.Action,
+			Match:        acl.Match,
+		})
+	}
+
+	// Sort the ACLs in the order OVN evaluates them
+	sort.SliceStable(sg.Status.ACLs, func(i, j int) bool {
+		a, b := sg.Status.ACLs[i], sg.Status.ACLs[j]
+		if a.Direction != b.Direction {
+			return a.Direction > b.Direction
+		}
+		if a.Priority != b.Priority {
+			return a.Priority > b.Priority
+		}
+
+		return a.Match < b.Match
+	})
+
+	return sg, nil
+}
+
+// GetByUUID retrieves a security group by its Neutron UUID
+func (m *Manager) GetByUUID(ctx context.Context, uuid types.UID) (*apiv1alpha1.SecurityGroup, error) {
+	pgs := []nbdb.PortGroup{}
+	if err := m.client.WhereCache(func(pg *nbdb.PortGroup) bool {
+		return pg.ExternalIDs[securityGroupIDKey] == string(uuid)
+	}).List(ctx, &pgs); err != nil {
+		return nil, fmt.Errorf("failed to get security group %q: %w", uuid, err)
+	}
+
+	if len(pgs) == 0 {
+		return nil, fmt.Errorf("security group %q not found", uuid)
+	}
+
+	return m.convertToSecurityGroup(ctx, &pgs[0])
+}
+
+// List retrieves all security groups
+func (m *Manager) List(ctx context.Context) (*apiv1alpha1.SecurityGroupList, error) {
+	var pgs []nbdb.PortGroup
+	if err := m.client.WhereCache(isSecurityGroup).List(ctx, &pgs); err != nil {
+		return nil, err
+	}
+
+	result := &apiv1alpha1.SecurityGroupList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "SecurityGroupList",
+			APIVersion: "atmosphere.vexxhost.com/v1alpha1",
+		},
+		Items: make([]apiv1alpha1.SecurityGroup, 0, len(pgs)),
+	}
+
+	for _, pg := range pgs {
+		sg, err := m.convertToSecurityGroup(ctx, &pg)
+		if err != nil {
+			continue
+		}
+
+		result.Items = append(result.Items, *sg)
+	}
+
+	return result, nil
+}
+
+// FormatMatch makes an OVN match expression easier to read by removing the
+// redundant port group clause and normalizing whitespace
+func FormatMatch(acl *apiv1alpha1.SecurityGroupACL, portGroup string) string {
+	match := strings.Join(strings.Fields(acl.Match), " ")
+
+	for _, clause := range []string{
+		"inport == @" + portGroup + " && ",
+		"outport == @" + portGroup + " && ",
+	} {
+		match = strings.Replace(match, clause, "", 1)
+	}
+
+	return match
+}
@@ -0,0 +1,139 @@
+// Copyright 2025 VEXXHOST, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ovnsecuritygroup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	apiv1alpha1 "github.com/vexxhost/atmosphere/apis/v1alpha1"
+)
+
+const (
+	testSGUUID    = "7d5b3f1e-2a4c-4e6f-8b1d-3c5e7a9b1d2f"
+	testRuleUUID  = "1c3e5a7b-9d2f-4b6a-8c1e-5f7a9b3d2c4e"
+	testRuleUUID2 = "2d4f6b8c-0e3a-4c7b-9d2f-6a8b0c4e3d5f"
+	testPortGroup = "pg_7d5b3f1e_2a4c_4e6f_8b1d_3c5e7a9b1d2f"
+)
+
+func TestList(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	nbClient, cleanup, err := libovsdb.NewNBTestHarness(libovsdb.TestSetup{
+		NBData: []libovsdb.TestData{
+			&nbdb.ACL{
+				UUID:        "acl-ingress",
+				Direction:   nbdb.ACLDirectionToLport,
+				Priority:    1002,
+				Action:      nbdb.ACLActionAllowRelated,
+				Match:       "outport == @" + testPortGroup + " && ip4 && ip4.src == 0.0.0.0/0 && tcp && tcp.dst == 22",
+				ExternalIDs: map[string]string{"neutron:security_group_rule_id": testRuleUUID},
+			},
+			&nbdb.ACL{
+				UUID:        "acl-egress",
+				Direction:   nbdb.ACLDirectionFromLport,
+				Priority:    1002,
+				Action:      nbdb.ACLActionAllowRelated,
+				Match:       "inport == @" + testPortGroup + " && ip4",
+				ExternalIDs: map[string]string{"neutron:security_group_rule_id": testRuleUUID2},
+			},
+			&nbdb.ACL{
+				UUID:      "acl-drop",
+				Direction: nbdb.ACLDirectionToLport,
+				Priority:  1001,
+				Action:    nbdb.ACLActionDrop,
+				Match:     "outport == @neutron_pg_drop && ip",
+			},
+			&nbdb.LogicalSwitchPort{UUID: "lsp-1", Name: "lsp-1"},
+			&nbdb.LogicalSwitchPort{UUID: "lsp-2", Name: "lsp-2"},
+			&nbdb.LogicalSwitch{Name: "neutron-net", Ports: []string{"lsp-1", "lsp-2"}},
+			&nbdb.PortGroup{
+				UUID:        "pg-sg",
+				Name:        testPortGroup,
+				ACLs:        []string{"acl-egress", "acl-ingress"},
+				Ports:       []string{"lsp-1", "lsp-2"},
+				ExternalIDs: map[string]string{"neutron:security_group_id": testSGUUID},
+			},
+			&nbdb.PortGroup{
+				UUID: "pg-drop",
+				Name: "neutron_pg_drop",
+				ACLs: []string{"acl-drop"},
+			},
+		},
+	}, nil)
+	require.NoError(t, err)
+	t.Cleanup(cleanup.Cleanup)
+
+	list, err := NewManager(nbClient).List(ctx)
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+
+	sg := list.Items[0]
+	assert.Equal(t, types.UID(testSGUUID), sg.UID)
+	assert.Equal(t, testPortGroup, sg.Status.PortGroup)
+	assert.Equal(t, 2, sg.Status.Ports)
+	require.Len(t, sg.Status.ACLs, 2)
+
+	assert.Equal(t, DirectionIngress, sg.Status.ACLs[0].Direction)
+	assert.Equal(t, types.UID(testRuleUUID), sg.Status.ACLs[0].RuleID)
+	assert.Equal(t, DirectionEgress, sg.Status.ACLs[1].Direction)
+	assert.Equal(t, types.UID(testRuleUUID2), sg.Status.ACLs[1].RuleID)
+}
+
+func TestGetByUUID_NotFound(t *testing.T) {
+	nbClient, cleanup, err := libovsdb.NewNBTestHarness(libovsdb.TestSetup{}, nil)
+	require.NoError(t, err)
+	t.Cleanup(cleanup.Cleanup)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err = NewManager(nbClient).GetByUUID(ctx, testSGUUID)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestFormatMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		match    string
+		expected string
+	}{
+		{
+			name:     "ingress",
+			match:    "outport == @" + testPortGroup + " && ip4 && ip4.src == 0.0.0.0/0 && tcp && tcp.dst == 22",
+			expected: "ip4 && ip4.src == 0.0.0.0/0 && tcp && tcp.dst == 22",
+		},
+		{
+			name:     "egress",
+			match:    "inport == @" + testPortGroup + " && ip6",
+			expected: "ip6",
+		},
+		{
+			name:     "remote group",
+			match:    "outport == @" + testPortGroup + " &&  ip4 && ip4.src == $" + testPortGroup + "_ip4",
+			expected: "ip4 && ip4.src == $" + testPortGroup + "_ip4",
+		},
+		{
+			name:     "other port group",
+			match:    "outport == @neutron_pg_drop && ip",
+			expected: "outport == @neutron_pg_drop && ip",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			acl := &apiv1alpha1.SecurityGroupACL{Match: tt.match}
+			assert.Equal(t, tt.expected, FormatMatch(acl, testPortGroup))
+		})
+	}
+}